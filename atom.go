@@ -0,0 +1,118 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Atom elements used within RSS documents.
+package rss
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The namespace of Atom elements (e.g. <atom:link>).
+//
+// See: https://www.rfc-editor.org/rfc/rfc4287
+const ATOMNAMESPACE = "http://www.w3.org/2005/Atom"
+
+// <atom:link> is an optional sub-element of <channel>. A channel may contain
+// any number of <atom:link>s.
+//
+// Example:
+//
+//	<atom:link href="https://example.com/rss.xml" rel="self" type="application/rss+xml" />
+//
+// It is commonly used to identify the URL of the feed itself (rel="self"),
+// WebSub hubs (rel="hub"), and pages of paged feeds (rel="next").
+//
+// See:
+//   - https://www.rssboard.org/rss-profile#namespace-elements-atom-link
+//   - https://www.rfc-editor.org/rfc/rfc4287#section-4.2.7
+type AtomLink struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2005/Atom link"` // required
+	Href    Href     `xml:"href,attr"`                        // required
+	Rel     Rel      `xml:"rel,attr,omitempty"`               // optional
+	Type    Type     `xml:"type,attr,omitempty"`              // optional
+}
+
+// Returns whether <atom:link> is valid and a slice containing any errors.
+func (r AtomLink) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	if r.Href == nil {
+		msg := fmt.Sprintf("Attribute 'href' of <%s> is required", r.XMLName.Local)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, ErrInvalidElement))
+	} else {
+		msg := fmt.Sprintf("Attribute 'href' of <%s> value '%s' is invalid", r.XMLName.Local, *r.Href)
+		if ok, err := IsNotEmpty(*r.Href); !ok {
+			isValid = false
+			errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+		} else if ok, err := IsValidURI(*r.Href); !ok {
+			isValid = false
+			errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+		}
+	}
+	return isValid, errs
+}
+
+func (r AtomLink) validate(o validateOptions) (bool, []error) {
+	isValid, errs := r.IsValid()
+	if r.Href == nil {
+		return isValid, errs
+	}
+	msg := fmt.Sprintf("Attribute 'href' of <%s> value '%s' is invalid", r.XMLName.Local, *r.Href)
+	return o.requireHTTPS(isValid, errs, msg, *r.Href)
+}
+
+// Returns the relation type of <atom:link>. If 'rel' is not present, the
+// relation type is "alternate".
+func (r AtomLink) RelType() string {
+	if r.Rel == nil || *r.Rel == "" {
+		return "alternate"
+	}
+	return *r.Rel
+}
+
+// 'href' is a required attribute of <atom:link>.
+//
+// See: https://www.rfc-editor.org/rfc/rfc4287#section-4.2.7.1
+type Href *string
+
+// 'rel' is an optional attribute of <atom:link>.
+//
+// See: https://www.rfc-editor.org/rfc/rfc4287#section-4.2.7.2
+type Rel *string
+
+// <atom:updated> is an optional sub-element of <item>. It contains the time
+// the item was last updated (RFC3339).
+//
+// Example:
+//
+//	<atom:updated>2003-06-03T09:39:21Z</atom:updated>
+//
+// Some Atom-oriented readers expect it alongside <pubDate> (see
+// WithAtomUpdated).
+//
+// See: https://www.rfc-editor.org/rfc/rfc4287#section-4.2.15
+type AtomUpdated struct {
+	XMLName  xml.Name `xml:"http://www.w3.org/2005/Atom updated"` // required
+	CharData []byte   `xml:",chardata"`                           // required
+}
+
+// Returns whether <atom:updated> is valid and a slice containing any errors.
+//
+// <atom:updated> must be a date and time with a time zone (RFC3339).
+func (r AtomUpdated) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
+	if ok, err := IsNotEmpty(string(r.CharData)); !ok {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+	} else if _, err := time.Parse(time.RFC3339, strings.TrimSpace(string(r.CharData))); err != nil {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be a valid date (RFC3339)", msg, ErrInvalidValue))
+	}
+	return isValid, errs
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtomLink(t *testing.T) {
+	t.Run("test <atom:link> - ok", func(t *testing.T) {
+		r := AtomLink{
+			XMLName: xml.Name{Space: ATOMNAMESPACE, Local: "link"},
+			Href:    Ptr("https://example.com/rss.xml"),
+			Rel:     Ptr("self"),
+		}
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		assert.Equal(t, "self", r.RelType())
+		s, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		assert.Equal(t, `<link xmlns="http://www.w3.org/2005/Atom" href="https://example.com/rss.xml" rel="self"></link>`, string(s))
+	})
+	t.Run("test <atom:link> - ok - default rel", func(t *testing.T) {
+		r := AtomLink{Href: Ptr("https://example.com")}
+		assert.Equal(t, "alternate", r.RelType())
+	})
+	t.Run("test <atom:link href=\"...\"> - fail - nil", func(t *testing.T) {
+		r := AtomLink{XMLName: xml.Name{Space: ATOMNAMESPACE, Local: "link"}}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidElement)
+		assert.ErrorContains(t, errs[0], "Attribute 'href' of <link> is required")
+	})
+	t.Run("test <atom:link href=\"...\"> - fail - invalid uri", func(t *testing.T) {
+		r := AtomLink{XMLName: xml.Name{Space: ATOMNAMESPACE, Local: "link"}, Href: Ptr("bad uri")}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidURI)
+	})
+}
+
+func TestAtomUpdated(t *testing.T) {
+	t.Run("test <atom:updated> - ok", func(t *testing.T) {
+		ret, errs := ValidateElementXML("atom:updated", []byte(`<atom:updated xmlns:atom="http://www.w3.org/2005/Atom">2003-06-03T09:39:21Z</atom:updated>`))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test <atom:updated> - fail - rfc822", func(t *testing.T) {
+		r := AtomUpdated{XMLName: xml.Name{Space: ATOMNAMESPACE, Local: "updated"}, CharData: []byte("Tue, 03 Jun 2003 09:39:21 GMT")}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+	})
+}
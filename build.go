@@ -0,0 +1,112 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Functions for building RSS documents.
+package rss
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+// ChannelMeta contains the metadata of <channel> as plain Go values.
+//
+// Zero values are omitted from the resulting <channel>.
+type ChannelMeta struct {
+	Title          string    // required
+	Link           string    // required
+	Description    string    // required
+	Language       string    // optional (e.g. "en-us")
+	Copyright      string    // optional
+	ManagingEditor string    // optional
+	WebMaster      string    // optional
+	PubDate        time.Time // optional
+	LastBuildDate  time.Time // optional
+	Generator      string    // optional
+	Docs           string    // optional
+	TTL            int       // optional (minutes)
+}
+
+// A BuildOption configures how an RSS document is built.
+type BuildOption func(*buildOptions)
+
+type buildOptions struct {
+	lastBuildDateFromItems bool
+}
+
+// Sets the <lastBuildDate> of <channel> to the latest valid <pubDate> of its
+// <item>s, overriding ChannelMeta.LastBuildDate. If no item has a valid
+// <pubDate> (e.g. the channel has no items), <lastBuildDate> is omitted.
+//
+// By default, <lastBuildDate> is ChannelMeta.LastBuildDate.
+func WithLastBuildDateFromItems() BuildOption {
+	return func(o *buildOptions) { o.lastBuildDateFromItems = true }
+}
+
+// Builds an RSS document from 'meta' and 'items'.
+//
+// The resulting document is validated. If it is invalid, a *ValidationError
+// is returned.
+//
+// NOTE: The <item>s of the resulting document are 'items' themselves, not
+// copies. Any empty XMLName fields of 'items' (e.g. of items constructed
+// without them) are set, as by RSS.EnsureXMLNames.
+func BuildFeed(meta ChannelMeta, items []*Item, opts ...BuildOption) (*RSS, error) {
+	o := buildOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	c := &Channel{
+		XMLName:        xml.Name{Space: "", Local: "channel"},
+		Title:          Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte(meta.Title)},
+		Link:           Link{XMLName: xml.Name{Space: "", Local: "link"}, CharData: []byte(meta.Link)},
+		Description:    Description{XMLName: xml.Name{Space: "", Local: "description"}, CharData: []byte(meta.Description)},
+		Language:       Language(meta.Language),
+		Copyright:      Copyright(meta.Copyright),
+		ManagingEditor: ManagingEditor(meta.ManagingEditor),
+		WebMaster:      WebMaster(meta.WebMaster),
+		Generator:      Generator(meta.Generator),
+		Docs:           Docs(meta.Docs),
+		Item:           append([]*Item{}, items...),
+	}
+	if !meta.PubDate.IsZero() {
+		c.PubDate = &PubDate{XMLName: xml.Name{Space: "", Local: "pubDate"}, CharData: []byte(FormatDate(meta.PubDate))}
+	}
+	if o.lastBuildDateFromItems {
+		meta.LastBuildDate = latestPubDate(items)
+	}
+	if !meta.LastBuildDate.IsZero() {
+		c.LastBuildDate = &LastBuildDate{XMLName: xml.Name{Space: "", Local: "lastBuildDate"}, CharData: []byte(FormatDate(meta.LastBuildDate))}
+	}
+	if meta.TTL != 0 {
+		c.TTL = &TTL{XMLName: xml.Name{Space: "", Local: "ttl"}, CharData: []byte(strconv.Itoa(meta.TTL))}
+	}
+	r := &RSS{
+		XMLName: xml.Name{Space: "", Local: "rss"},
+		Version: RSSVERSION,
+		Channel: c,
+	}
+	// Items may have been constructed without XML names.
+	r.EnsureXMLNames()
+	if ok, errs := r.IsValid(); !ok {
+		return nil, &ValidationError{Errors: errs}
+	}
+	return r, nil
+}
+
+// Returns the latest valid <pubDate> of 'items' or the zero time if there is
+// none.
+func latestPubDate(items []*Item) time.Time {
+	latest := time.Time{}
+	for _, item := range items {
+		if item == nil || item.PubDate == nil {
+			continue
+		}
+		if t, err := ParseDate(string(item.PubDate.CharData)); err == nil && t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
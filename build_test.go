@@ -0,0 +1,125 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildFeed(t *testing.T) {
+	meta := ChannelMeta{
+		Title:       "Example",
+		Link:        "https://example.com",
+		Description: "An example feed.",
+		Language:    "en-us",
+		PubDate:     time.Date(2022, time.January, 2, 15, 4, 5, 0, time.UTC),
+		TTL:         60,
+	}
+	t.Run("test build feed - ok", func(t *testing.T) {
+		items := []*Item{
+			{Title: &Title{CharData: []byte("First")}, Link: &Link{CharData: []byte("https://example.com/1")}},
+			{Title: &Title{CharData: []byte("Second")}, Link: &Link{CharData: []byte("https://example.com/2")}},
+			{Description: &Description{CharData: []byte("Third")}},
+		}
+		r, err := BuildFeed(meta, items)
+		assert.Nil(t, err)
+		assert.Equal(t, "rss", r.XMLName.Local)
+		assert.Equal(t, Version(RSSVERSION), r.Version)
+		assert.Equal(t, "Example", string(r.Channel.Title.CharData))
+		assert.Equal(t, "Sun, 02 Jan 2022 15:04:05 GMT", string(r.Channel.PubDate.CharData))
+		assert.Equal(t, "60", string(r.Channel.TTL.CharData))
+		assert.Len(t, r.Channel.Item, 3)
+		assert.Equal(t, "title", r.Channel.Item[0].Title.XMLName.Local)
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test build feed - fail - missing title", func(t *testing.T) {
+		meta := meta
+		meta.Title = ""
+		r, err := BuildFeed(meta, nil)
+		assert.Nil(t, r)
+		var verr *ValidationError
+		assert.True(t, errors.As(err, &verr))
+		assert.ErrorIs(t, err, ErrEmptyValue)
+	})
+	t.Run("test build feed - fail - invalid item", func(t *testing.T) {
+		r, err := BuildFeed(meta, []*Item{{}})
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, ErrInvalidElement)
+	})
+	t.Run("test build feed - items slice copied", func(t *testing.T) {
+		items := []*Item{{Title: &Title{CharData: []byte("First")}}}
+		r, err := BuildFeed(meta, items)
+		assert.Nil(t, err)
+		items[0] = &Item{Title: &Title{CharData: []byte("Replaced")}}
+		assert.Equal(t, "First", string(r.Channel.Item[0].Title.CharData))
+	})
+}
+
+type testError struct{ msg string }
+
+func (e *testError) Error() string { return e.msg }
+
+func TestValidationError(t *testing.T) {
+	verr := &ValidationError{Errors: []error{
+		fmt.Errorf("Element <title> is invalid: %w", ErrEmptyValue),
+		fmt.Errorf("Element <link> is invalid: %w", &testError{msg: "test"}),
+	}}
+	t.Run("test validation error - error", func(t *testing.T) {
+		assert.Equal(t, "Element <title> is invalid: Element must not have empty value; Element <link> is invalid: test", verr.Error())
+	})
+	t.Run("test validation error - is", func(t *testing.T) {
+		assert.True(t, verr.Is(ErrEmptyValue))
+		assert.False(t, verr.Is(ErrInvalidURI))
+		assert.ErrorIs(t, fmt.Errorf("wrapped: %w", verr), ErrEmptyValue)
+	})
+	t.Run("test validation error - as", func(t *testing.T) {
+		var target *testError
+		assert.True(t, verr.As(&target))
+		assert.Equal(t, "test", target.msg)
+		var perr *os.PathError
+		assert.False(t, verr.As(&perr))
+	})
+}
+
+func TestBuildFeedWithLastBuildDateFromItems(t *testing.T) {
+	meta := ChannelMeta{
+		Title:         "Example",
+		Link:          "https://example.com",
+		Description:   "An example feed.",
+		LastBuildDate: time.Date(2022, time.January, 2, 15, 4, 5, 0, time.UTC),
+	}
+	t.Run("test build feed with last build date from items - populated", func(t *testing.T) {
+		items := []*Item{
+			{Title: &Title{CharData: []byte("First")}, PubDate: &PubDate{CharData: []byte("Fri, 30 May 2003 11:06:42 GMT")}},
+			{Title: &Title{CharData: []byte("Second")}, PubDate: &PubDate{CharData: []byte("Tue, 03 Jun 2003 09:39:21 GMT")}},
+			{Title: &Title{CharData: []byte("Third")}},
+		}
+		r, err := BuildFeed(meta, items, WithLastBuildDateFromItems())
+		assert.Nil(t, err)
+		assert.Equal(t, "Tue, 03 Jun 2003 09:39:21 GMT", string(r.Channel.LastBuildDate.CharData))
+	})
+	t.Run("test build feed with last build date from items - empty", func(t *testing.T) {
+		r, err := BuildFeed(meta, nil, WithLastBuildDateFromItems())
+		assert.Nil(t, err)
+		assert.Nil(t, r.Channel.LastBuildDate)
+		s, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		assert.NotContains(t, string(s), "lastBuildDate")
+	})
+	t.Run("test build feed without last build date from items", func(t *testing.T) {
+		r, err := BuildFeed(meta, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "Sun, 02 Jan 2022 15:04:05 GMT", string(r.Channel.LastBuildDate.CharData))
+	})
+}
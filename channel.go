@@ -0,0 +1,291 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Helper methods for <channel>.
+package rss
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The time to live used when <channel> does not contain a valid <ttl>.
+const defaultTTL = 60 * time.Minute
+
+// Returns the categories of all items in <channel> grouped by their top-level
+// segment.
+//
+// Only categories without a 'domain' attribute are included, since the
+// hierarchy of a category with a domain is defined by the domain's taxonomy.
+//
+// Example:
+//
+//	<category>News/World</category>
+//	<category>News/Local</category>
+//
+// results in:
+//
+//	map[string][]string{"News": {"World", "Local"}}
+func (r *Channel) CategoryTree() map[string][]string {
+	tree := map[string][]string{}
+	for _, item := range r.Item {
+		if item == nil {
+			continue
+		}
+		for _, category := range item.Category {
+			if category == nil || category.Domain != nil {
+				continue
+			}
+			segments := category.Segments()
+			if len(segments) == 0 {
+				continue
+			}
+			top, sub := segments[0], strings.Join(segments[1:], "/")
+			if _, ok := tree[top]; !ok {
+				tree[top] = []string{}
+			}
+			if sub != "" && !contains(tree[top], sub) {
+				tree[top] = append(tree[top], sub)
+			}
+		}
+	}
+	return tree
+}
+
+// Returns the earliest time after 'after' at which <channel> should be polled.
+//
+// The time is 'after' plus the <ttl> of the channel (or one hour if <ttl> is
+// not present or invalid), advanced to the start of the next hour until it no
+// longer falls within an hour in <skipHours> or a day in <skipDays>. Hours and
+// days are interpreted in GMT.
+//
+// See:
+//   - https://validator.w3.org/feed/docs/rss2.html#ltttlgtSubelementOfLtchannelgt
+//   - https://www.rssboard.org/skip-hours-days
+func (r *Channel) NextPollTime(after time.Time) time.Time {
+	ttl := defaultTTL
+	if r.TTL != nil {
+		if i, err := strconv.ParseUint(string(r.TTL.CharData), 10, 0); err == nil {
+			ttl = time.Duration(i) * time.Minute
+		}
+	}
+	skipHours := map[int]bool{}
+	if r.SkipHours != nil {
+		for _, h := range r.SkipHours.Hour {
+			if h != nil {
+				skipHours[int(*h)] = true
+			}
+		}
+	}
+	skipDays := map[time.Weekday]bool{}
+	if r.SkipDays != nil {
+		for _, d := range r.SkipDays.Day {
+			if d == nil {
+				continue
+			}
+			for wd := time.Sunday; wd <= time.Saturday; wd++ {
+				if strings.EqualFold(strings.TrimSpace(string(*d)), wd.String()) {
+					skipDays[wd] = true
+				}
+			}
+		}
+	}
+	t := after.Add(ttl)
+	// Advance at most one week, in case every hour is skipped.
+	for i := 0; i < 7*24; i++ {
+		u := t.UTC()
+		if !skipHours[u.Hour()] && !skipDays[u.Weekday()] {
+			return t
+		}
+		t = u.Truncate(time.Hour).Add(time.Hour).In(t.Location())
+	}
+	return after.Add(ttl)
+}
+
+// Returns the <enclosure> URLs shared by more than one <item> of <channel>, in
+// order of first occurrence. Each URL is returned once.
+//
+// Repeated enclosure URLs usually indicate a templating bug.
+func (r *Channel) DuplicateEnclosureURLs() []string {
+	counts := map[string]int{}
+	duplicates := []string{}
+	for _, item := range r.Item {
+		if item == nil || item.Enclosure == nil || item.Enclosure.URL == nil || *item.Enclosure.URL == "" {
+			continue
+		}
+		u := *item.Enclosure.URL
+		if counts[u]++; counts[u] == 2 {
+			duplicates = append(duplicates, u)
+		}
+	}
+	return duplicates
+}
+
+// Returns the <title> of <channel>.
+//
+// NOTE: Channel has fields named Title, Link, Description, and Generator, so
+// these getters are suffixed with "String".
+func (r *Channel) TitleString() string { return string(r.Title.CharData) }
+
+// Returns the <link> of <channel>.
+func (r *Channel) LinkString() string { return string(r.Link.CharData) }
+
+// Returns the <description> of <channel>.
+func (r *Channel) DescriptionString() string { return string(r.Description.CharData) }
+
+// Returns the <generator> of <channel> or an empty string if it is not
+// present.
+func (r *Channel) GeneratorString() string { return string(r.Generator) }
+
+// Returns the 'href' of the first <atom:link> of <channel> with relation type
+// 'rel' (e.g. "self", "hub", or "next") and whether one was found.
+func (r *Channel) LinkByRel(rel string) (string, bool) {
+	for _, link := range r.AtomLink {
+		if link == nil || link.Href == nil {
+			continue
+		}
+		if link.RelType() == rel {
+			return *link.Href, true
+		}
+	}
+	return "", false
+}
+
+// Returns the 'href' of each <atom:link rel="hub"> of <channel>, which are
+// the WebSub hubs to which the feed is published.
+//
+// An 'href' that is not a valid absolute URI (e.g. an empty or relative URL)
+// cannot identify a hub and is omitted.
+//
+// See: https://www.w3.org/TR/websub/#discovery
+func (r *Channel) Hubs() []string {
+	hubs := []string{}
+	for _, link := range r.AtomLink {
+		if link == nil || link.Href == nil || link.RelType() != "hub" {
+			continue
+		}
+		if u, err := url.Parse(*link.Href); err != nil || !u.IsAbs() || u.Host == "" {
+			continue
+		}
+		hubs = append(hubs, *link.Href)
+	}
+	return hubs
+}
+
+// Returns the 'href' of the <atom:link rel="self"> of <channel>, which is the
+// URL of the feed itself (i.e. the WebSub topic), and whether it was found.
+func (r *Channel) Self() (string, bool) { return r.LinkByRel("self") }
+
+// Adds an <atom:link rel="self" type="application/rss+xml"> with 'href'
+// 'feedURL' (e.g. the URL from which the feed was fetched) to <channel>, if
+// it does not already contain one.
+//
+// An error is returned if 'feedURL' is not a valid URI, in which case
+// <channel> is not modified.
+func (r *Channel) EnsureSelfLink(feedURL string) error {
+	msg := fmt.Sprintf("Attribute 'href' of <atom:link> value '%s' is invalid", feedURL)
+	if ok, err := IsNotEmpty(feedURL); !ok {
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+	if ok, err := IsValidURI(feedURL); !ok {
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+	if _, ok := r.Self(); ok {
+		return nil
+	}
+	rel, typ := "self", "application/rss+xml"
+	r.AtomLink = append(r.AtomLink, &AtomLink{
+		XMLName: xml.Name{Space: ATOMNAMESPACE, Local: "link"},
+		Href:    &feedURL,
+		Rel:     &rel,
+		Type:    &typ,
+	})
+	return nil
+}
+
+// Sets the <image> of <channel> with the given <url>, <title>, and <link>
+// and, if non-zero, <width> and <height>.
+//
+// If 'width' or 'height' is zero, the element is omitted and its default
+// value applies (88 and 31, respectively). If the resulting <image> is
+// invalid (e.g. 'width' is greater than 144 or 'url' is not a valid URI), a
+// *ValidationError is returned and <channel> is not modified.
+func (r *Channel) SetImage(url, title, link string, width, height int) error {
+	image := &Image{
+		XMLName: xml.Name{Space: "", Local: "image"},
+		URL:     &url,
+		Title:   Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte(title)},
+		Link:    Link{XMLName: xml.Name{Space: "", Local: "link"}, CharData: []byte(link)},
+	}
+	if width != 0 {
+		image.Width = Width(strconv.Itoa(width))
+	}
+	if height != 0 {
+		image.Height = Height(strconv.Itoa(height))
+	}
+	if ok, errs := image.IsValid(); !ok {
+		return &ValidationError{Errors: errs}
+	}
+	r.Image = image
+	return nil
+}
+
+// Returns the number of <item> <pubDate>s of <channel> that use each date
+// format, keyed by layout: time.RFC822 or time.RFC1123 for dates with a
+// two-character or four-character year and a named time zone (e.g. "GMT"),
+// and time.RFC822Z or time.RFC1123Z for those with a numeric time zone (e.g.
+// "+0000").
+//
+// Dates that are not valid are not counted. A feed whose items use several
+// formats (e.g. both "GMT" and "+0000") is valid, but may indicate a bug in
+// the program that generated it.
+func (r *Channel) DateFormats() map[string]int {
+	formats := map[string]int{}
+	for _, item := range r.Item {
+		if item == nil || item.PubDate == nil {
+			continue
+		}
+		s := string(item.PubDate.CharData)
+		_, layout, err := parseDate(s)
+		if err != nil {
+			continue
+		}
+		// The time package parses a numeric time zone of "+0000" as a named
+		// time zone, so the zone is classified by its form instead.
+		if zone := s[strings.LastIndex(s, " ")+1:]; strings.HasPrefix(zone, "+") || strings.HasPrefix(zone, "-") {
+			switch layout {
+			case time.RFC822:
+				layout = time.RFC822Z
+			case time.RFC1123:
+				layout = time.RFC1123Z
+			}
+		}
+		formats[layout]++
+	}
+	return formats
+}
+
+// Returns the number of <item>s of <channel> whose <description> has the same
+// text as their <title>, ignoring case and differences in whitespace.
+//
+// A feed in which most items do so carries no real summaries. Such a feed is
+// valid, but may be flagged by quality checks.
+func (r *Channel) TitleEqualsDescriptionCount() int {
+	normalize := func(b []byte) string { return strings.Join(strings.Fields(string(b)), " ") }
+	n := 0
+	for _, item := range r.Item {
+		if item == nil || item.Title == nil || item.Description == nil {
+			continue
+		}
+		title := normalize(item.Title.CharData)
+		if title != "" && strings.EqualFold(title, normalize(item.Description.CharData)) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,507 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelCategoryTree(t *testing.T) {
+	t.Run("test category tree", func(t *testing.T) {
+		r := Channel{
+			Item: []*Item{
+				{Category: []*Category{{CharData: []byte("News/World")}}},
+				{Category: []*Category{{CharData: []byte("News/Local")}}},
+				{Category: []*Category{{CharData: []byte("News/World")}}},
+				{Category: []*Category{{CharData: []byte("Sports")}}},
+				{Category: []*Category{{CharData: []byte("Tech/Go"), Domain: Ptr("dmoz")}}},
+				{},
+			},
+		}
+		want := map[string][]string{
+			"News":   {"World", "Local"},
+			"Sports": {},
+		}
+		assert.Equal(t, want, r.CategoryTree())
+	})
+}
+
+func TestChannelNextPollTime(t *testing.T) {
+	// Monday, 2 January 2006 at 12:30 GMT.
+	after := time.Date(2006, time.January, 2, 12, 30, 0, 0, time.UTC)
+	t.Run("test next poll time - default ttl", func(t *testing.T) {
+		var r Channel
+		assert.Equal(t, after.Add(time.Hour), r.NextPollTime(after))
+	})
+	t.Run("test next poll time - ttl", func(t *testing.T) {
+		r := Channel{TTL: &TTL{CharData: []byte("15")}}
+		assert.Equal(t, after.Add(15*time.Minute), r.NextPollTime(after))
+	})
+	t.Run("test next poll time - skipped hour", func(t *testing.T) {
+		r := Channel{
+			TTL:       &TTL{CharData: []byte("60")},
+			SkipHours: &SkipHours{Hour: []*Hour{Ptr(Hour(13)), Ptr(Hour(14))}},
+		}
+		want := time.Date(2006, time.January, 2, 15, 0, 0, 0, time.UTC)
+		assert.Equal(t, want, r.NextPollTime(after))
+	})
+	t.Run("test next poll time - skipped day", func(t *testing.T) {
+		r := Channel{
+			TTL:      &TTL{CharData: []byte("60")},
+			SkipDays: &SkipDays{Day: []*Day{Ptr(Day("Monday"))}},
+		}
+		want := time.Date(2006, time.January, 3, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, want, r.NextPollTime(after))
+	})
+	t.Run("test next poll time - unmarshal skipDays", func(t *testing.T) {
+		var r SkipDays
+		err := xml.Unmarshal([]byte(`<skipDays><day>Saturday</day><day>Sunday</day></skipDays>`), &r)
+		assert.Nil(t, err)
+		assert.Equal(t, []*Day{Ptr(Day("Saturday")), Ptr(Day("Sunday"))}, r.Day)
+	})
+}
+
+func TestChannelMarshalOrder(t *testing.T) {
+	t.Run("test marshal order - items last", func(t *testing.T) {
+		var r Channel
+		r.Item = []*Item{{Title: &Title{CharData: []byte("Item")}}}
+		r.TTL = &TTL{CharData: []byte("60")}
+		r.Description = Description{CharData: []byte("Description")}
+		r.Link = Link{CharData: []byte("https://example.com")}
+		r.Title = Title{CharData: []byte("Title")}
+		b, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		s := string(b)
+		title := strings.Index(s, "<title>Title</title>")
+		link := strings.Index(s, "<link>")
+		ttl := strings.Index(s, "<ttl>")
+		item := strings.Index(s, "<item>")
+		assert.True(t, title >= 0 && title < link)
+		assert.True(t, link < ttl)
+		assert.True(t, ttl < item)
+	})
+	t.Run("test marshal order - atom:link after rss elements", func(t *testing.T) {
+		var r Channel
+		r.Item = []*Item{{Title: &Title{CharData: []byte("Item")}}}
+		r.AtomLink = []*AtomLink{{Href: Ptr("https://example.com/feed"), Rel: Ptr("self")}}
+		r.SkipDays = &SkipDays{Day: []*Day{Ptr(Day("Sunday"))}}
+		r.Link = Link{CharData: []byte("https://example.com")}
+		r.Title = Title{CharData: []byte("Title")}
+		b, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		s := string(b)
+		link := strings.Index(s, "<link>")
+		skipDays := strings.Index(s, "<skipDays>")
+		atomLink := strings.Index(s, `href="https://example.com/feed"`)
+		item := strings.Index(s, "<item>")
+		assert.True(t, link >= 0 && link < skipDays)
+		assert.True(t, skipDays < atomLink)
+		assert.True(t, atomLink < item)
+	})
+}
+
+func TestChannelDuplicateEnclosureURLs(t *testing.T) {
+	enclosure := func(url string) *Enclosure {
+		return &Enclosure{URL: Ptr(url), Length: Ptr("0"), Type: Ptr("audio/mpeg")}
+	}
+	t.Run("test duplicate enclosure urls", func(t *testing.T) {
+		r := Channel{
+			Item: []*Item{
+				{Enclosure: enclosure("https://example.com/1.mp3")},
+				{Enclosure: enclosure("https://example.com/2.mp3")},
+				{},
+				{Enclosure: enclosure("https://example.com/1.mp3")},
+				{Enclosure: enclosure("https://example.com/1.mp3")},
+			},
+		}
+		assert.Equal(t, []string{"https://example.com/1.mp3"}, r.DuplicateEnclosureURLs())
+	})
+	t.Run("test duplicate enclosure urls - none", func(t *testing.T) {
+		r := Channel{
+			Item: []*Item{
+				{Enclosure: enclosure("https://example.com/1.mp3")},
+				{Enclosure: enclosure("https://example.com/2.mp3")},
+			},
+		}
+		assert.Empty(t, r.DuplicateEnclosureURLs())
+	})
+}
+
+func TestChannelGetters(t *testing.T) {
+	t.Run("test getters - populated", func(t *testing.T) {
+		r := Channel{
+			Title:       Title{CharData: []byte("Title")},
+			Link:        Link{CharData: []byte("https://example.com")},
+			Description: Description{CharData: []byte("Description")},
+			Generator:   Generator("Generator"),
+		}
+		assert.Equal(t, "Title", r.TitleString())
+		assert.Equal(t, "https://example.com", r.LinkString())
+		assert.Equal(t, "Description", r.DescriptionString())
+		assert.Equal(t, "Generator", r.GeneratorString())
+	})
+	t.Run("test getters - empty", func(t *testing.T) {
+		var r Channel
+		assert.Equal(t, "", r.TitleString())
+		assert.Equal(t, "", r.LinkString())
+		assert.Equal(t, "", r.DescriptionString())
+		assert.Equal(t, "", r.GeneratorString())
+	})
+}
+
+func TestChannelIsValidItemIndex(t *testing.T) {
+	c := Channel{
+		XMLName:     xml.Name{Space: "", Local: "channel"},
+		Title:       Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+		Link:        Link{XMLName: xml.Name{Space: "", Local: "link"}, CharData: []byte("https://example.com")},
+		Description: Description{XMLName: xml.Name{Space: "", Local: "description"}, CharData: []byte("Description")},
+	}
+	t.Run("test item index - guid", func(t *testing.T) {
+		r := c
+		r.Item = []*Item{
+			{XMLName: xml.Name{Space: "", Local: "item"}, Title: &Title{CharData: []byte("First")}},
+			{XMLName: xml.Name{Space: "", Local: "item"}, Title: &Title{CharData: []byte("Second")}},
+			{
+				XMLName: xml.Name{Space: "", Local: "item"},
+				GUID:    &GUID{XMLName: xml.Name{Space: "", Local: "guid"}, CharData: []byte("https://example.com/3")},
+			},
+		}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidElement)
+		assert.ErrorContains(t, errs[0], "channel > item[2] (guid 'https://example.com/3'): Element <item> is invalid")
+	})
+	t.Run("test item index - link", func(t *testing.T) {
+		r := c
+		r.Item = []*Item{
+			{XMLName: xml.Name{Space: "", Local: "item"}, Link: &Link{CharData: []byte("https://example.com/1")}},
+		}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "channel > item[0] (link 'https://example.com/1'): Element <item> is invalid")
+	})
+}
+
+func TestChannelIsValid(t *testing.T) {
+	t.Run("test channel - podcast", func(t *testing.T) {
+		r, err := ParseFile("test/data/samples/sample-podcast.xml")
+		assert.Nil(t, err)
+		ret, errs := r.Channel.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test channel - invalid sub-element", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(`<rss version="2.0"><channel><title>Title</title>` +
+			`<link>https://example.com</link><description>Description</description>` +
+			`<image><title>Title</title><link>https://example.com</link></image></channel></rss>`))
+		assert.Nil(t, err)
+		ret, errs := r.Channel.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "Element <image> is invalid: Element must contain required sub-elements and/or attributes: <url> must be present")
+	})
+}
+
+func TestChannelIsValidSkipHours(t *testing.T) {
+	t.Run("test skip hours - invalid hour", func(t *testing.T) {
+		r := Channel{
+			XMLName:     xml.Name{Space: "", Local: "channel"},
+			Title:       Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+			Link:        Link{XMLName: xml.Name{Space: "", Local: "link"}, CharData: []byte("https://example.com")},
+			Description: Description{XMLName: xml.Name{Space: "", Local: "description"}, CharData: []byte("Description")},
+			SkipHours: &SkipHours{
+				XMLName: xml.Name{Space: "", Local: "skipHours"},
+				Hour:    []*Hour{Ptr(Hour(0)), Ptr(Hour(24))},
+			},
+		}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		assert.ErrorContains(t, errs[0], "channel > skipHours > hour[1]: Element <hour> value '24' is invalid")
+	})
+}
+
+func TestChannelIsValidSkipDays(t *testing.T) {
+	t.Run("test skip days - invalid day", func(t *testing.T) {
+		r := Channel{
+			XMLName:     xml.Name{Space: "", Local: "channel"},
+			Title:       Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+			Link:        Link{XMLName: xml.Name{Space: "", Local: "link"}, CharData: []byte("https://example.com")},
+			Description: Description{XMLName: xml.Name{Space: "", Local: "description"}, CharData: []byte("Description")},
+			SkipDays: &SkipDays{
+				XMLName: xml.Name{Space: "", Local: "skipDays"},
+				Day:     []*Day{Ptr(Day("Monday")), Ptr(Day("Tuesday")), Ptr(Day("Caturday"))},
+			},
+		}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		assert.ErrorContains(t, errs[0], "channel > skipDays > day[2]: Element <day> value 'Caturday' is invalid")
+	})
+}
+
+func TestChannelLinkByRel(t *testing.T) {
+	data := []byte(`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Title</title><link>https://example.com</link><atom:link href="https://example.com/rss.xml" rel="self" type="application/rss+xml"/><atom:link href="https://hub.example.com" rel="hub"/><description>Description</description></channel></rss>`)
+	var r RSS
+	err := xml.Unmarshal(data, &r)
+	assert.Nil(t, err)
+	t.Run("test link by rel - self", func(t *testing.T) {
+		href, ok := r.Channel.LinkByRel("self")
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/rss.xml", href)
+	})
+	t.Run("test link by rel - hub", func(t *testing.T) {
+		href, ok := r.Channel.LinkByRel("hub")
+		assert.True(t, ok)
+		assert.Equal(t, "https://hub.example.com", href)
+	})
+	t.Run("test link by rel - none", func(t *testing.T) {
+		href, ok := r.Channel.LinkByRel("next")
+		assert.False(t, ok)
+		assert.Equal(t, "", href)
+	})
+	t.Run("test link by rel - link", func(t *testing.T) {
+		assert.Equal(t, "https://example.com", r.Channel.LinkString())
+		assert.Len(t, r.Channel.AtomLink, 2)
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+}
+
+func TestChannelHubsSelf(t *testing.T) {
+	data := []byte(`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Title</title><link>https://example.com</link><description>Description</description><atom:link href="https://pubsubhubbub.example.com/" rel="hub"/><atom:link href="https://example.com/rss.xml" rel="self"/></channel></rss>`)
+	t.Run("test hubs and self", func(t *testing.T) {
+		var r RSS
+		err := xml.Unmarshal(data, &r)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"https://pubsubhubbub.example.com/"}, r.Channel.Hubs())
+		self, ok := r.Channel.Self()
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/rss.xml", self)
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test hubs and self - fail - invalid hub", func(t *testing.T) {
+		var r RSS
+		err := xml.Unmarshal(bytes.Replace(data, []byte("https://pubsubhubbub.example.com/"), []byte("not a uri"), 1), &r)
+		assert.Nil(t, err)
+		assert.Empty(t, r.Channel.Hubs())
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidURI)
+	})
+	t.Run("test hubs - invalid hrefs omitted", func(t *testing.T) {
+		r := Channel{AtomLink: []*AtomLink{
+			{Href: Ptr(""), Rel: Ptr("hub")},
+			{Href: Ptr("/hub"), Rel: Ptr("hub")},
+			{Href: Ptr("https://hub.example.com/"), Rel: Ptr("hub")},
+			{Href: Ptr("https://example.com/rss.xml"), Rel: Ptr("self")},
+		}}
+		assert.Equal(t, []string{"https://hub.example.com/"}, r.Hubs())
+	})
+	t.Run("test hubs and self - none", func(t *testing.T) {
+		var r Channel
+		assert.Empty(t, r.Hubs())
+		_, ok := r.Self()
+		assert.False(t, ok)
+	})
+}
+
+func TestChannelEnsureSelfLink(t *testing.T) {
+	t.Run("test ensure self link - absent", func(t *testing.T) {
+		var r Channel
+		assert.Nil(t, r.EnsureSelfLink("https://example.com/rss.xml"))
+		self, ok := r.Self()
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/rss.xml", self)
+		assert.Len(t, r.AtomLink, 1)
+		s, err := xml.Marshal(r.AtomLink[0])
+		assert.Nil(t, err)
+		assert.Equal(t, `<link xmlns="http://www.w3.org/2005/Atom" href="https://example.com/rss.xml" rel="self" type="application/rss+xml"></link>`, string(s))
+		ret, errs := r.AtomLink[0].IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		// EnsureSelfLink is idempotent.
+		assert.Nil(t, r.EnsureSelfLink("https://example.com/rss.xml"))
+		assert.Len(t, r.AtomLink, 1)
+	})
+	t.Run("test ensure self link - present", func(t *testing.T) {
+		r := Channel{AtomLink: []*AtomLink{{Href: Ptr("https://example.com/feed"), Rel: Ptr("self")}}}
+		assert.Nil(t, r.EnsureSelfLink("https://example.com/rss.xml"))
+		self, ok := r.Self()
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/feed", self)
+		assert.Len(t, r.AtomLink, 1)
+	})
+	t.Run("test ensure self link - fail - invalid url", func(t *testing.T) {
+		var r Channel
+		err := r.EnsureSelfLink("not a uri")
+		assert.ErrorIs(t, err, ErrInvalidURI)
+		assert.ErrorContains(t, err, "Attribute 'href' of <atom:link> value 'not a uri' is invalid")
+		assert.Empty(t, r.AtomLink)
+		assert.ErrorIs(t, r.EnsureSelfLink(""), ErrEmptyValue)
+		assert.Empty(t, r.AtomLink)
+	})
+}
+
+func TestChannelCategories(t *testing.T) {
+	data := []byte(`<channel><title>Title</title><link>https://example.com</link><description>Description</description><category>News</category><category domain="https://example.com/categories">Technology/Go</category></channel>`)
+	t.Run("test categories - round-trip", func(t *testing.T) {
+		var r Channel
+		err := xml.Unmarshal(data, &r)
+		assert.Nil(t, err)
+		assert.Len(t, r.Category, 2)
+		assert.Equal(t, "News", string(r.Category[0].CharData))
+		assert.Nil(t, r.Category[0].Domain)
+		assert.Equal(t, "Technology/Go", string(r.Category[1].CharData))
+		assert.Equal(t, "https://example.com/categories", *r.Category[1].Domain)
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		s, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		assert.Equal(t, string(data), string(s))
+	})
+	t.Run("test categories - fail - invalid category", func(t *testing.T) {
+		var r Channel
+		err := xml.Unmarshal(data, &r)
+		assert.Nil(t, err)
+		r.Category[1].CharData = []byte("")
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrEmptyValue)
+		assert.ErrorContains(t, errs[0], "channel > category[1]: ")
+	})
+}
+
+func TestChannelIsValidImage(t *testing.T) {
+	c := Channel{
+		XMLName:     xml.Name{Space: "", Local: "channel"},
+		Title:       Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+		Link:        Link{XMLName: xml.Name{Space: "", Local: "link"}, CharData: []byte("https://example.com")},
+		Description: Description{XMLName: xml.Name{Space: "", Local: "description"}, CharData: []byte("Description")},
+	}
+	t.Run("test image - nil", func(t *testing.T) {
+		r := c
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test image - fail - missing url", func(t *testing.T) {
+		r := c
+		r.Image = &Image{
+			XMLName: xml.Name{Space: "", Local: "image"},
+			Title:   Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+			Link:    Link{XMLName: xml.Name{Space: "", Local: "link"}, CharData: []byte("https://example.com")},
+		}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidElement)
+		assert.ErrorContains(t, errs[0], "Element <image> is invalid")
+		assert.ErrorContains(t, errs[0], "<url> must be present")
+	})
+}
+
+func TestChannelSetImage(t *testing.T) {
+	t.Run("test set image", func(t *testing.T) {
+		var r Channel
+		err := r.SetImage("https://example.com/logo.png", "Title", "https://example.com", 0, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com/logo.png", *r.Image.URL)
+		assert.Equal(t, "Title", string(r.Image.Title.CharData))
+		assert.Equal(t, "https://example.com", string(r.Image.Link.CharData))
+		assert.Equal(t, Width(""), r.Image.Width)
+		assert.Equal(t, Height(""), r.Image.Height)
+		err = r.SetImage("https://example.com/logo.png", "Title", "https://example.com", 144, 400)
+		assert.Nil(t, err)
+		assert.Equal(t, Width("144"), r.Image.Width)
+		assert.Equal(t, Height("400"), r.Image.Height)
+		s, err := xml.Marshal(r.Image)
+		assert.Nil(t, err)
+		assert.Equal(t, `<image><url>https://example.com/logo.png</url><title>Title</title>`+
+			`<link>https://example.com</link><width>144</width><height>400</height></image>`, string(s))
+	})
+	t.Run("test set image - fail - width", func(t *testing.T) {
+		var r Channel
+		err := r.SetImage("https://example.com/logo.png", "Title", "https://example.com", 145, 0)
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.ErrorContains(t, err, "Element <width> value '145' is invalid")
+		assert.Nil(t, r.Image)
+	})
+	t.Run("test set image - fail - url", func(t *testing.T) {
+		var r Channel
+		err := r.SetImage("not a url", "Title", "https://example.com", 0, 0)
+		assert.ErrorIs(t, err, ErrInvalidURI)
+		assert.Nil(t, r.Image)
+	})
+}
+
+func TestChannelDateFormats(t *testing.T) {
+	t.Run("test date formats", func(t *testing.T) {
+		r := Channel{
+			Item: []*Item{
+				{PubDate: &PubDate{CharData: []byte("Tue, 03 Jun 2003 09:39:21 GMT")}},
+				{PubDate: &PubDate{CharData: []byte("Fri, 30 May 2003 11:06:42 GMT")}},
+				{PubDate: &PubDate{CharData: []byte("29 May 03 08:37 GMT")}},
+				{PubDate: &PubDate{CharData: []byte("not a date")}},
+				{},
+			},
+		}
+		assert.Equal(t, map[string]int{time.RFC1123: 2, time.RFC822: 1}, r.DateFormats())
+	})
+	t.Run("test date formats - named and numeric time zones", func(t *testing.T) {
+		r := Channel{
+			Item: []*Item{
+				{PubDate: &PubDate{CharData: []byte("Tue, 03 Jun 2003 09:39:21 GMT")}},
+				{PubDate: &PubDate{CharData: []byte("Fri, 30 May 2003 11:06:42 +0000")}},
+				{PubDate: &PubDate{CharData: []byte("29 May 03 08:37 +0000")}},
+			},
+		}
+		assert.Equal(t, map[string]int{time.RFC1123: 1, time.RFC1123Z: 1, time.RFC822Z: 1}, r.DateFormats())
+	})
+	t.Run("test date formats - none", func(t *testing.T) {
+		var r Channel
+		assert.Empty(t, r.DateFormats())
+	})
+}
+
+func TestChannelTitleEqualsDescriptionCount(t *testing.T) {
+	newItem := func(title, description string) *Item {
+		return &Item{Title: &Title{CharData: []byte(title)}, Description: &Description{CharData: []byte(description)}}
+	}
+	t.Run("test title equals description count", func(t *testing.T) {
+		r := Channel{
+			Item: []*Item{
+				newItem("Title", "Title"),
+				newItem("A  title", " a title\n"),
+				newItem("Other", "OTHER"),
+				newItem("Title", "A summary of the item."),
+				newItem("", ""),
+				{Title: &Title{CharData: []byte("Title")}},
+				nil,
+			},
+		}
+		assert.Equal(t, 3, r.TitleEqualsDescriptionCount())
+	})
+	t.Run("test title equals description count - none", func(t *testing.T) {
+		var r Channel
+		assert.Equal(t, 0, r.TitleEqualsDescriptionCount())
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Character set functions for the rss package.
+package rss
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// The names of the supported character sets other than UTF-8.
+const (
+	latin1  = "ISO-8859-1"
+	usASCII = "US-ASCII"
+)
+
+// Labels of the ISO-8859-1 character set, in lowercase.
+//
+// See: https://www.iana.org/assignments/character-sets/character-sets.xhtml
+var latin1Labels = map[string]bool{
+	"iso-8859-1":      true,
+	"iso_8859-1":      true,
+	"iso_8859-1:1987": true,
+	"iso8859-1":       true,
+	"latin1":          true,
+	"l1":              true,
+	"ibm819":          true,
+	"cp819":           true,
+}
+
+// Returns the canonical name of the character set 'label' (e.g. "ISO-8859-1"
+// for "latin1") or an empty string if it is not supported.
+//
+// Only ISO-8859-1 and US-ASCII, a subset of UTF-8, are supported.
+func canonicalCharset(label string) string {
+	switch l := strings.ToLower(label); {
+	case latin1Labels[l]:
+		return latin1
+	case l == "us-ascii" || l == "ascii":
+		return usASCII
+	default:
+		return ""
+	}
+}
+
+// Returns the canonical name of the character set 'label' and a reader that
+// decodes 'input' from it to UTF-8.
+func charsetReader(label string, input io.Reader) (string, io.Reader, error) {
+	switch charset := canonicalCharset(label); charset {
+	case latin1:
+		return charset, &latin1Reader{r: input}, nil
+	case usASCII:
+		return charset, input, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported charset '%s'", label)
+	}
+}
+
+// Returns a reader that decodes 'input' from the character set 'label' to
+// UTF-8, for use as the CharsetReader of an xml.Decoder.
+func newCharsetReader(label string, input io.Reader) (io.Reader, error) {
+	_, r, err := charsetReader(label, input)
+	return r, err
+}
+
+// A latin1Reader decodes ISO-8859-1 read from 'r' to UTF-8. Each byte of
+// ISO-8859-1 is the code point of the corresponding character.
+type latin1Reader struct {
+	r   io.Reader
+	buf []byte // decoded bytes not yet returned
+	err error  // error returned by 'r'
+}
+
+func (l *latin1Reader) Read(p []byte) (int, error) {
+	for len(l.buf) == 0 && l.err == nil {
+		// Each byte decodes to at most two bytes of UTF-8.
+		in := make([]byte, len(p)/2+1)
+		n, err := l.r.Read(in)
+		for _, b := range in[:n] {
+			l.buf = utf8.AppendRune(l.buf, rune(b))
+		}
+		l.err = err
+	}
+	n := copy(p, l.buf)
+	l.buf = l.buf[n:]
+	if len(l.buf) == 0 {
+		return n, l.err
+	}
+	return n, nil
+}
+
+// Returns the UTF-8 'b' encoded as 'charset' (ISO-8859-1 or US-ASCII).
+// Characters that are not in 'charset' are replaced by character references
+// (e.g. "&#8364;" for "€").
+func encodeCharset(b []byte, charset string) []byte {
+	limit := rune(0x100)
+	if charset == usASCII {
+		limit = 0x80
+	}
+	out := make([]byte, 0, len(b))
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		b = b[size:]
+		if r < limit {
+			out = append(out, byte(r))
+		} else {
+			out = append(out, fmt.Sprintf("&#%d;", r)...)
+		}
+	}
+	return out
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCharset(t *testing.T) {
+	// "Café" and "Crème" encoded as ISO-8859-1.
+	data := []byte("<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n" +
+		"<rss version=\"2.0\"><channel><title>Caf\xe9</title><link>https://example.com</link>" +
+		"<description>Cr\xe8me</description></channel></rss>")
+	t.Run("test charset - parse", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data))
+		assert.Nil(t, err)
+		assert.Equal(t, "ISO-8859-1", r.Charset)
+		assert.Equal(t, "Café", r.Channel.TitleString())
+		assert.Equal(t, "Crème", r.Channel.DescriptionString())
+	})
+	t.Run("test charset - parse - one byte at a time", func(t *testing.T) {
+		r, err := Parse(iotest.OneByteReader(bytes.NewReader(data)))
+		assert.Nil(t, err)
+		assert.Equal(t, "Café", r.Channel.TitleString())
+	})
+	t.Run("test charset - round-trip", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data))
+		assert.Nil(t, err)
+		r.Channel.Description.CharData = []byte("Crème €5")
+		var buf bytes.Buffer
+		n, err := r.Write(&buf, WithOriginalCharset(), WithIndent("", ""))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(buf.Len()), n)
+		assert.True(t, strings.HasPrefix(buf.String(), "<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n"))
+		assert.Contains(t, buf.String(), "<title>Caf\xe9</title>")
+		assert.Contains(t, buf.String(), "<description>Cr\xe8me &#8364;5</description>")
+		parsed, err := Parse(&buf)
+		assert.Nil(t, err)
+		assert.Equal(t, "ISO-8859-1", parsed.Charset)
+		assert.Equal(t, "Café", parsed.Channel.TitleString())
+		assert.Equal(t, "Crème €5", parsed.Channel.DescriptionString())
+	})
+	t.Run("test charset - utf-8 by default", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data))
+		assert.Nil(t, err)
+		var buf bytes.Buffer
+		_, err = r.Write(&buf)
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(buf.String(), `<?xml version="1.0" encoding="UTF-8"?>`))
+		assert.Contains(t, buf.String(), "<title>Café</title>")
+	})
+	t.Run("test charset - utf-8 with original charset", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(`<rss version="2.0"><channel><title>Café</title></channel></rss>`))
+		assert.Nil(t, err)
+		assert.Equal(t, "", r.Charset)
+		var buf bytes.Buffer
+		_, err = r.Write(&buf, WithOriginalCharset())
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(buf.String(), `<?xml version="1.0" encoding="UTF-8"?>`))
+		assert.Contains(t, buf.String(), "<title>Café</title>")
+	})
+	t.Run("test charset - parse with offsets", func(t *testing.T) {
+		r, offsets, err := ParseWithOffsets(bytes.NewReader(data))
+		assert.Nil(t, err)
+		assert.Equal(t, "Café", r.Channel.TitleString())
+		title := offsets["rss > channel > title"]
+		assert.Equal(t, "<title>Caf\xe9</title>", string(data[title.Start:title.End]))
+		description := offsets["rss > channel > description"]
+		assert.Equal(t, "<description>Cr\xe8me</description>", string(data[description.Start:description.End]))
+		channel := offsets["rss > channel"]
+		assert.True(t, strings.HasSuffix(string(data[channel.Start:channel.End]), "</channel>"))
+	})
+	t.Run("test charset - item decoder", func(t *testing.T) {
+		d := NewItemDecoder(bytes.NewReader([]byte("<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n" +
+			"<rss version=\"2.0\"><channel><title>Caf\xe9</title><item><title>Cr\xe8me</title></item></channel></rss>")))
+		item, err := d.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, "Crème", string(item.Title.CharData))
+		_, err = d.Next()
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, "Café", d.Channel().TitleString())
+	})
+	t.Run("test charset - us-ascii", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(`<?xml version="1.0" encoding="US-ASCII"?>` +
+			`<rss version="2.0"><channel><title>Cafe</title></channel></rss>`))
+		assert.Nil(t, err)
+		assert.Equal(t, "US-ASCII", r.Charset)
+		r.Channel.Title.CharData = []byte("Café €5")
+		var buf bytes.Buffer
+		_, err = r.Write(&buf, WithOriginalCharset(), WithIndent("", ""))
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(buf.String(), "<?xml version=\"1.0\" encoding=\"US-ASCII\"?>\n"))
+		assert.Contains(t, buf.String(), "<title>Caf&#233; &#8364;5</title>")
+		parsed, err := Parse(&buf)
+		assert.Nil(t, err)
+		assert.Equal(t, "Café €5", parsed.Channel.TitleString())
+	})
+	t.Run("test charset - fail - unsupported", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(`<?xml version="1.0" encoding="Shift_JIS"?><rss version="2.0"></rss>`))
+		assert.Nil(t, r)
+		assert.ErrorContains(t, err, "unsupported charset 'Shift_JIS'")
+	})
+}
+
+func TestLatin1Reader(t *testing.T) {
+	t.Run("test latin-1 reader", func(t *testing.T) {
+		b, err := io.ReadAll(&latin1Reader{r: bytes.NewReader([]byte("A\xe9\xff"))})
+		assert.Nil(t, err)
+		assert.Equal(t, "Aéÿ", string(b))
+		assert.Nil(t, iotest.TestReader(&latin1Reader{r: bytes.NewReader([]byte("Caf\xe9 cr\xe8me"))}, []byte("Café crème")))
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Dublin Core elements used within RSS documents.
+package rss
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The namespace of Dublin Core elements (e.g. <dc:date>).
+//
+// See: https://www.dublincore.org/specifications/dublin-core/dcmi-terms/
+const DCNAMESPACE = "http://purl.org/dc/elements/1.1/"
+
+// <dc:date> is an optional sub-element of <item>. It is used by some feeds
+// instead of <pubDate>.
+//
+// Example:
+//
+//	<dc:date>2003-06-03T09:39:21Z</dc:date>
+//
+// See:
+//   - https://www.rssboard.org/rss-profile#namespace-elements-dublin-date
+//   - https://www.w3.org/TR/NOTE-datetime
+type DCDate struct {
+	XMLName  xml.Name `xml:"http://purl.org/dc/elements/1.1/ date"` // required
+	CharData []byte   `xml:",chardata"`                             // required
+}
+
+// Returns whether <dc:date> is valid and a slice containing any errors.
+//
+// <dc:date> must be a date (e.g. "2003-06-03") or a date and time with a time
+// zone (e.g. "2003-06-03T09:39:21Z") conforming to ISO8601 (W3C-DTF).
+func (r DCDate) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
+	if ok, err := IsNotEmpty(string(r.CharData)); !ok {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+	} else if _, err := parseISO8601(string(r.CharData)); err != nil {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be a valid date (ISO8601)", msg, ErrInvalidValue))
+	}
+	return isValid, errs
+}
+
+// Returns the time of <dc:date>.
+func (r DCDate) Time() (time.Time, error) {
+	t, err := parseISO8601(string(r.CharData))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: must be a valid date (ISO8601): %v", ErrInvalidValue, err)
+	}
+	return t, nil
+}
+
+// <dc:creator> is an optional sub-element of <item>. It contains the name of
+// the author of the item and is used by some feeds instead of <author>, which
+// must contain an email address.
+//
+// Example:
+//
+//	<dc:creator>Jane Doe</dc:creator>
+//
+// See: https://www.rssboard.org/rss-profile#namespace-elements-dublin-creator
+type DCCreator struct {
+	XMLName  xml.Name `xml:"http://purl.org/dc/elements/1.1/ creator"` // required
+	CharData []byte   `xml:",chardata"`                                // required
+}
+
+// Returns whether <dc:creator> is valid and a slice containing any errors.
+func (r DCCreator) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
+	if ok, err := IsNotEmpty(string(r.CharData)); !ok {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+	}
+	return isValid, errs
+}
+
+// The layouts accepted by parseISO8601, in order of precedence.
+var iso8601Layouts = []string{time.RFC3339Nano, "2006-01-02T15:04Z07:00", "2006-01-02"}
+
+// Parses 's' as a date (ISO8601).
+func parseISO8601(s string) (time.Time, error) {
+	var err error
+	for _, layout := range iso8601Layouts {
+		var t time.Time
+		if t, err = time.Parse(layout, strings.TrimSpace(s)); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDCDate(t *testing.T) {
+	for _, s := range []string{"2003-06-03T09:39:21Z", "2003-06-03T09:39:21.5+02:00", "2003-06-03T09:39Z", "2003-06-03"} {
+		t.Run("test <dc:date> - ok - "+s, func(t *testing.T) {
+			r := DCDate{XMLName: xml.Name{Space: DCNAMESPACE, Local: "date"}, CharData: []byte(s)}
+			ret, errs := r.IsValid()
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+			_, err := r.Time()
+			assert.Nil(t, err)
+		})
+	}
+	t.Run("test <dc:date> - ok - round-trip", func(t *testing.T) {
+		data := []byte(`<item xmlns:dc="http://purl.org/dc/elements/1.1/"><title>Title</title><dc:date>2003-06-03T09:39:21Z</dc:date></item>`)
+		var r Item
+		err := xml.Unmarshal(data, &r)
+		assert.Nil(t, err)
+		assert.Equal(t, "2003-06-03T09:39:21Z", string(r.DCDate.CharData))
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		s, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		assert.Equal(t, `<item><title>Title</title><date xmlns="http://purl.org/dc/elements/1.1/">2003-06-03T09:39:21Z</date></item>`, string(s))
+	})
+	t.Run("test <dc:date> - fail - rfc822", func(t *testing.T) {
+		r := DCDate{XMLName: xml.Name{Space: DCNAMESPACE, Local: "date"}, CharData: []byte("Tue, 03 Jun 2003 09:39:21 GMT")}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		assert.EqualError(t, errs[0], "Element <date> value 'Tue, 03 Jun 2003 09:39:21 GMT' is invalid: "+
+			"Element or attribute must have valid value: must be a valid date (ISO8601)")
+		tm, err := r.Time()
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Equal(t, time.Time{}, tm)
+	})
+	t.Run("test <dc:date> - fail - empty", func(t *testing.T) {
+		r := DCDate{XMLName: xml.Name{Space: DCNAMESPACE, Local: "date"}}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrEmptyValue)
+	})
+}
+
+func TestDCCreator(t *testing.T) {
+	t.Run("test <dc:creator> - ok", func(t *testing.T) {
+		ret, errs := ValidateElementXML("dc:creator", []byte(`<dc:creator xmlns:dc="http://purl.org/dc/elements/1.1/">Jane Doe</dc:creator>`))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test <dc:creator> - fail - empty", func(t *testing.T) {
+		r := DCCreator{XMLName: xml.Name{Space: DCNAMESPACE, Local: "creator"}}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrEmptyValue)
+	})
+}
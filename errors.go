@@ -5,7 +5,10 @@
 // Errors for the rss package.
 package rss
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var ErrEmptyValue = errors.New("Element must not have empty value")
 var ErrNonEmptyValue = errors.New("Element must not have value")
@@ -14,3 +17,66 @@ var ErrInvalidValue = errors.New("Element or attribute must have valid value")
 var ErrInvalidDate = errors.New("Element must contain a valid date (RFC822)")
 var ErrInvalidMailAddress = errors.New("Element must contain a valid mail address (RFC5322)")
 var ErrInvalidURI = errors.New("Element must contain a valid URI (RFC3986)")
+var ErrUnknownElement = errors.New("Element is not a supported RSS element")
+var ErrUnexpectedStatus = errors.New("Response must have a successful status")
+var ErrUnknownContentLength = errors.New("Response must contain a valid Content-Length")
+var ErrInvalidNamespace = errors.New("Element must not be in a non-RSS namespace")
+var ErrItemLimitReached = errors.New("Channel must not contain more items than the limit")
+var ErrSurroundingWhitespace = errors.New("Element must not have leading or trailing whitespace")
+
+// ValidationError is returned when an RSS document is invalid. Errors
+// contains each of the errors returned by its IsValid method.
+type ValidationError struct {
+	Errors []error
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Returns the errors of the ValidationError, so that errors.Is and errors.As
+// match any of them.
+func (e *ValidationError) Unwrap() []error { return e.Errors }
+
+// Returns whether any of the errors of the ValidationError matches 'target'.
+//
+// NOTE: errors.Is only unwraps Unwrap() []error as of Go 1.20.
+func (e *ValidationError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Finds the first of the errors of the ValidationError that matches 'target'
+// and, if one is found, sets 'target' to that error value and returns true.
+//
+// NOTE: errors.As only unwraps Unwrap() []error as of Go 1.20.
+func (e *ValidationError) As(target any) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Warning is returned by ValidateWith for a problem that does not make an RSS
+// document invalid (e.g. an unparsable <pubDate> under WithLenientDates). Err
+// is the error that would otherwise have been returned.
+//
+// Use errors.As to distinguish warnings from errors.
+type Warning struct {
+	Err error
+}
+
+func (w *Warning) Error() string { return "warning: " + w.Err.Error() }
+
+// Returns the error of the Warning, so that errors.Is matches it.
+func (w *Warning) Unwrap() error { return w.Err }
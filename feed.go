@@ -0,0 +1,695 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Helper methods for <rss>.
+package rss
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Returns the content type of the RSS document (e.g. for the Content-Type
+// header of an HTTP response).
+func (r *RSS) ContentType() string { return CONTENTTYPE }
+
+// FeedStats contains statistics about the items of an RSS document.
+type FeedStats struct {
+	Items               int       // number of <item>s
+	ItemsWithEnclosure  int       // number of <item>s with an <enclosure>
+	ItemsWithGUID       int       // number of <item>s with a <guid>
+	DistinctCategories  int       // number of distinct <item> <category> values
+	EarliestPubDate     time.Time // earliest valid <item> <pubDate>
+	LatestPubDate       time.Time // latest valid <item> <pubDate>
+	TotalEnclosureBytes int64     // sum of valid <enclosure> 'length' values
+}
+
+// Returns statistics about the items of the RSS document.
+//
+// Invalid <pubDate> and <enclosure> 'length' values are ignored.
+func (r *RSS) Stats() FeedStats {
+	stats := FeedStats{}
+	if r.Channel == nil {
+		return stats
+	}
+	categories := map[string]bool{}
+	for _, item := range r.Channel.Item {
+		if item == nil {
+			continue
+		}
+		stats.Items++
+		if item.Enclosure != nil {
+			stats.ItemsWithEnclosure++
+			if item.Enclosure.Length != nil {
+				if i, err := strconv.ParseInt(*item.Enclosure.Length, 10, 64); err == nil && i > 0 {
+					stats.TotalEnclosureBytes += i
+				}
+			}
+		}
+		if item.GUID != nil {
+			stats.ItemsWithGUID++
+		}
+		for _, category := range item.Category {
+			if category != nil {
+				categories[string(category.CharData)] = true
+			}
+		}
+		if item.PubDate != nil {
+			if t, err := ParseDate(string(item.PubDate.CharData)); err == nil {
+				if stats.EarliestPubDate.IsZero() || t.Before(stats.EarliestPubDate) {
+					stats.EarliestPubDate = t
+				}
+				if stats.LatestPubDate.IsZero() || t.After(stats.LatestPubDate) {
+					stats.LatestPubDate = t
+				}
+			}
+		}
+	}
+	stats.DistinctCategories = len(categories)
+	return stats
+}
+
+// Removes optional attributes with an empty value (e.g. domain="" on
+// <category> or isPermaLink="" on <guid>) from all elements, so that they are
+// omitted when marshaling.
+//
+// An optional attribute with an empty value is invalid, whereas an omitted
+// optional attribute is not. Prune is idempotent.
+func (r *RSS) Prune() {
+	walk(reflect.ValueOf(r), func(f reflect.StructField, v reflect.Value) {
+		tag := f.Tag.Get("xml")
+		if !strings.Contains(tag, ",attr") || !strings.Contains(tag, ",omitempty") {
+			return
+		}
+		if v.Kind() == reflect.Pointer && !v.IsNil() && v.Elem().Kind() == reflect.String && v.Elem().String() == "" {
+			v.Set(reflect.Zero(v.Type()))
+		}
+	})
+}
+
+// A NormalizeOption configures how the dates of an RSS document are
+// normalized.
+type NormalizeOption func(*normalizeOptions)
+
+type normalizeOptions struct {
+	fourDigitYears bool
+}
+
+// Rewrites dates with a two-character year (e.g. "01 Jan 70 00:00 GMT") with
+// a four-character year and the day of the week (e.g.
+// "Thu, 01 Jan 1970 00:00:00 GMT"). The time zone is left unchanged, so that
+// a date in a zone other than GMT (e.g. EST) is not shifted.
+//
+// Two-character years are expanded as by the time package: years 69 to 99
+// are in the 1900s, and years 00 to 68 are in the 2000s.
+func WithFourDigitYears() NormalizeOption {
+	return func(o *normalizeOptions) { o.fourDigitYears = true }
+}
+
+// Normalizes the <pubDate> and <lastBuildDate> of <channel> and the <pubDate>
+// of each <item>.
+//
+// Leading and trailing whitespace, which makes a date invalid, is removed.
+// Valid dates are otherwise left unchanged, unless rewritten by 'opts';
+// invalid dates are always otherwise left unchanged. NormalizeDates is
+// idempotent.
+func (r *RSS) NormalizeDates(opts ...NormalizeOption) {
+	o := normalizeOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if r.Channel == nil {
+		return
+	}
+	if r.Channel.PubDate != nil {
+		r.Channel.PubDate.CharData = normalizeDate(r.Channel.PubDate.CharData, o)
+	}
+	if r.Channel.LastBuildDate != nil {
+		r.Channel.LastBuildDate.CharData = normalizeDate(r.Channel.LastBuildDate.CharData, o)
+	}
+	for _, item := range r.Channel.Item {
+		if item != nil && item.PubDate != nil {
+			item.PubDate.CharData = normalizeDate(item.PubDate.CharData, o)
+		}
+	}
+}
+
+// Returns the date (RFC822) 'b' normalized according to 'o'.
+func normalizeDate(b []byte, o normalizeOptions) []byte {
+	s := strings.TrimSpace(string(b))
+	t, layout, err := parseDate(s)
+	if err != nil {
+		return []byte(s)
+	}
+	if o.fourDigitYears && layout == time.RFC822 {
+		return []byte(t.Format(time.RFC1123))
+	}
+	if o.fourDigitYears && layout == time.RFC822Z {
+		return []byte(t.Format(time.RFC1123Z))
+	}
+	return []byte(s)
+}
+
+// Populates the XMLName of every element that does not have one with its
+// correct name (e.g. "title" for Title).
+//
+// Elements constructed by hand often omit XMLName. Although encoding/xml
+// falls back to the name in the XMLName struct tag when marshaling, a missing
+// XMLName results in validation errors that refer to an empty element name
+// (e.g. "Element <> value ” is invalid").
+func (r *RSS) EnsureXMLNames() {
+	walk(reflect.ValueOf(r), func(f reflect.StructField, v reflect.Value) {
+		if f.Name != "XMLName" || f.Type != reflect.TypeOf(xml.Name{}) {
+			return
+		}
+		n := v.Addr().Interface().(*xml.Name)
+		if n.Local != "" {
+			return
+		}
+		n.Space, n.Local = tagName(f)
+	})
+}
+
+// Query parameters removed by StripQueryParams if none are specified. These
+// are commonly used to track the source of a visit.
+var trackingParams = []string{
+	"utm_source",
+	"utm_medium",
+	"utm_campaign",
+	"utm_term",
+	"utm_content",
+	"fbclid",
+	"gclid",
+}
+
+// Removes the query parameters 'params' (e.g. "utm_campaign") from every URL
+// in the RSS document. If 'params' is empty, common tracking parameters
+// (e.g. "utm_source") are removed.
+//
+// The remaining query parameters are preserved, in order. URLs that cannot be
+// parsed are left unchanged.
+//
+// URLs are taken from:
+//   - <link> of <channel>, <image>, <textInput>, and <item>
+//   - <url> of <image>
+//   - 'href' of <atom:link>
+//   - <comments> of <item>
+//   - 'url' of <source> and <enclosure>
+//
+// NOTE: <guid> is not modified, even if it is a permalink, as it identifies
+// the item.
+func (r *RSS) StripQueryParams(params ...string) {
+	if len(params) == 0 {
+		params = trackingParams
+	}
+	if r.Channel == nil {
+		return
+	}
+	c := r.Channel
+	c.Link.CharData = stripQueryParamsCharData(c.Link.CharData, params)
+	for _, link := range c.AtomLink {
+		if link != nil && link.Href != nil {
+			href := stripQueryParams(*link.Href, params)
+			link.Href = &href
+		}
+	}
+	if c.Image != nil {
+		c.Image.Link.CharData = stripQueryParamsCharData(c.Image.Link.CharData, params)
+		if c.Image.URL != nil {
+			u := stripQueryParams(*c.Image.URL, params)
+			c.Image.URL = &u
+		}
+	}
+	if c.TextInput != nil && c.TextInput.Link != nil {
+		c.TextInput.Link.CharData = stripQueryParamsCharData(c.TextInput.Link.CharData, params)
+	}
+	for _, item := range c.Item {
+		if item == nil {
+			continue
+		}
+		if item.Link != nil {
+			item.Link.CharData = stripQueryParamsCharData(item.Link.CharData, params)
+		}
+		if item.Comments != nil {
+			item.Comments.CharData = stripQueryParamsCharData(item.Comments.CharData, params)
+		}
+		if item.Source != nil && item.Source.URL != nil {
+			u := stripQueryParams(*item.Source.URL, params)
+			item.Source.URL = &u
+		}
+		if item.Enclosure != nil && item.Enclosure.URL != nil {
+			u := stripQueryParams(*item.Enclosure.URL, params)
+			item.Enclosure.URL = &u
+		}
+	}
+}
+
+// Returns the character data 'b' with the query parameters 'params' removed.
+// Empty (or absent) character data is returned unchanged.
+func stripQueryParamsCharData(b []byte, params []string) []byte {
+	if len(b) == 0 {
+		return b
+	}
+	return []byte(stripQueryParams(string(b), params))
+}
+
+// Returns 's' without the query parameters 'params'.
+func stripQueryParams(s string, params []string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.RawQuery == "" {
+		return s
+	}
+	kept := []string{}
+	for _, kv := range strings.Split(u.RawQuery, "&") {
+		k := strings.SplitN(kv, "=", 2)[0]
+		if key, err := url.QueryUnescape(k); err == nil && contains(params, key) {
+			continue
+		}
+		kept = append(kept, kv)
+	}
+	if len(kept) == strings.Count(u.RawQuery, "&")+1 {
+		return s
+	}
+	u.RawQuery = strings.Join(kept, "&")
+	return u.String()
+}
+
+// Resolves every relative URL in the RSS document (e.g. "/episodes/1") to an
+// absolute URL.
+//
+// URLs of <item> are resolved against the <link> of <item> or, if it is not
+// an absolute URI, the <link> of <channel>. The <link> of <item> itself and
+// other URLs are resolved against the <link> of <channel>. URLs are taken
+// from the same elements and attributes as StripQueryParams, except the
+// <link> of <channel>. Absolute URLs, empty URLs, and URLs that cannot be
+// parsed are left unchanged, as is every URL if there is no absolute base.
+//
+// NOTE: <guid> is not modified, even if it is a permalink, as it identifies
+// the item.
+func (r *RSS) ResolveLinks() {
+	if r.Channel == nil {
+		return
+	}
+	c := r.Channel
+	for _, link := range c.AtomLink {
+		if link != nil && link.Href != nil {
+			href := resolveLinkString(*link.Href, nil, c)
+			link.Href = &href
+		}
+	}
+	if c.Image != nil {
+		c.Image.Link.CharData = resolveLinkCharData(c.Image.Link.CharData, nil, c)
+		if c.Image.URL != nil {
+			u := resolveLinkString(*c.Image.URL, nil, c)
+			c.Image.URL = &u
+		}
+	}
+	if c.TextInput != nil && c.TextInput.Link != nil {
+		c.TextInput.Link.CharData = resolveLinkCharData(c.TextInput.Link.CharData, nil, c)
+	}
+	for _, item := range c.Item {
+		if item == nil {
+			continue
+		}
+		if item.Link != nil {
+			item.Link.CharData = resolveLinkCharData(item.Link.CharData, nil, c)
+		}
+		if item.Comments != nil {
+			item.Comments.CharData = resolveLinkCharData(item.Comments.CharData, item.Link, c)
+		}
+		if item.Source != nil && item.Source.URL != nil {
+			u := resolveLinkString(*item.Source.URL, item.Link, c)
+			item.Source.URL = &u
+		}
+		if item.Enclosure != nil && item.Enclosure.URL != nil {
+			u := resolveLinkString(*item.Enclosure.URL, item.Link, c)
+			item.Enclosure.URL = &u
+		}
+	}
+}
+
+// Returns the character data 'b' resolved by resolveLinkString.
+func resolveLinkCharData(b []byte, link *Link, c *Channel) []byte {
+	if len(b) == 0 {
+		return b
+	}
+	return []byte(resolveLinkString(string(b), link, c))
+}
+
+// Returns the URL 's' resolved by resolveLink. An empty or absolute 's' is
+// returned unchanged, since resolveLink would replace an empty 's' with its
+// base and may re-encode an absolute one.
+func resolveLinkString(s string, link *Link, c *Channel) string {
+	if u, err := url.Parse(s); s == "" || err != nil || u.IsAbs() {
+		return s
+	}
+	return resolveLink(s, link, c)
+}
+
+// Returns whether the RSS document is equal to 'other', ignoring all
+// <pubDate>, <lastBuildDate>, and <dc:date> elements.
+//
+// This is useful for comparing generated documents that are stamped with the
+// current time (e.g. in golden tests).
+func (r *RSS) EqualIgnoringDates(other *RSS) bool {
+	return equalIgnoringDates(reflect.ValueOf(r), reflect.ValueOf(other))
+}
+
+// Returns whether 'a' and 'b' are deeply equal, ignoring unexported struct
+// fields and struct fields of type PubDate, LastBuildDate, or DCDate (or
+// pointers to them).
+func equalIgnoringDates(a, b reflect.Value) bool {
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return equalIgnoringDates(a.Elem(), b.Elem())
+	case reflect.Slice:
+		// Character data ([]byte) contains no sub-elements.
+		if a.Type().Elem().Kind() == reflect.Uint8 {
+			return reflect.DeepEqual(a.Interface(), b.Interface())
+		}
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !equalIgnoringDates(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			f := a.Type().Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			t := f.Type
+			if t.Kind() == reflect.Pointer {
+				t = t.Elem()
+			}
+			if t == reflect.TypeOf(PubDate{}) || t == reflect.TypeOf(LastBuildDate{}) || t == reflect.TypeOf(DCDate{}) {
+				continue
+			}
+			if !equalIgnoringDates(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
+
+// Returns whether 'a' and 'b' represent the same logical feed (e.g. the same
+// feed fetched from different URLs).
+//
+// If both feeds have an <atom:link rel="self">, these are compared. Otherwise,
+// the <link>s of their channels are compared. URLs are compared without their
+// scheme (e.g. "http" and "https"), case-insensitive host, and trailing
+// slash.
+func SameFeed(a, b *RSS) bool {
+	if a == nil || b == nil || a.Channel == nil || b.Channel == nil {
+		return false
+	}
+	selfA, okA := a.Channel.Self()
+	selfB, okB := b.Channel.Self()
+	if okA && okB {
+		return normalizeFeedURL(selfA) == normalizeFeedURL(selfB)
+	}
+	linkA, linkB := a.Channel.LinkString(), b.Channel.LinkString()
+	if linkA == "" || linkB == "" {
+		return false
+	}
+	return normalizeFeedURL(linkA) == normalizeFeedURL(linkB)
+}
+
+// Returns 's' without its scheme and trailing slash, and with a lowercase
+// host (e.g. "example.com/feed" for "https://Example.com/feed/").
+func normalizeFeedURL(s string) string {
+	s = strings.TrimSpace(s)
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return strings.TrimSuffix(s, "/")
+	}
+	u.Scheme = ""
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return strings.TrimPrefix(u.String(), "//")
+}
+
+// A HashOption configures how the hash of an RSS document is computed.
+type HashOption func(*hashOptions)
+
+type hashOptions struct {
+	lastBuildDate bool
+}
+
+// Excludes the <lastBuildDate> of <channel>, which may change each time the
+// feed is generated even if its content does not, from the hash.
+func WithoutLastBuildDate() HashOption {
+	return func(o *hashOptions) { o.lastBuildDate = false }
+}
+
+// Returns a hash of the content of the RSS document, which is the metadata of
+// <channel> and the hash of each of its <item>s (see Item.Hash), in order.
+//
+// The hash can be stored and compared with the hash of a later fetch of the
+// feed to detect changes (e.g. if the server does not support conditional
+// requests). XML comments are not part of the content.
+//
+// The metadata of <channel> is hashed as XML. If it cannot be marshaled, its
+// map representation (see ToMap) is hashed instead, so that the hash still
+// reflects its content.
+func (r *RSS) ContentHash(opts ...HashOption) string {
+	o := hashOptions{lastBuildDate: true}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	h := sha256.New()
+	if r.Channel != nil {
+		c := *r.Channel
+		c.Item, c.XMLComment = nil, ""
+		if !o.lastBuildDate {
+			c.LastBuildDate = nil
+		}
+		if b, err := xml.Marshal(c); err == nil {
+			h.Write(b)
+		} else {
+			fmt.Fprint(h, (&RSS{Channel: &c}).ToMap())
+		}
+		h.Write([]byte{0})
+		for _, item := range r.Channel.Item {
+			if item != nil {
+				h.Write([]byte(item.Hash()))
+				h.Write([]byte{0})
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Returns the mail addresses of the RSS document, without duplicates, in
+// document order.
+//
+// Addresses are taken from the <managingEditor>, <webMaster>, and
+// <itunes:email> of <itunes:owner> of <channel> and the <author>s of each
+// <item>. Elements that do not contain a valid mail
+// address (RFC5322) are omitted. The domain of each address is lowercase; the
+// local part is case-sensitive and is left unchanged.
+func (r *RSS) EmailAddresses() []string {
+	emails := []string{}
+	if r.Channel == nil {
+		return emails
+	}
+	values := []string{string(r.Channel.ManagingEditor), string(r.Channel.WebMaster)}
+	if owner := r.Channel.ITunesOwner; owner != nil && owner.Email != nil {
+		values = append(values, string(owner.Email.CharData))
+	}
+	for _, item := range r.Channel.Item {
+		if item == nil {
+			continue
+		}
+		for _, author := range item.Author {
+			if author != nil {
+				values = append(values, string(author.CharData))
+			}
+		}
+	}
+	seen := map[string]bool{}
+	for _, v := range values {
+		a, err := mail.ParseAddress(strings.TrimSpace(v))
+		if err != nil {
+			continue
+		}
+		email := a.Address
+		if i := strings.LastIndex(email, "@"); i >= 0 {
+			email = email[:i] + strings.ToLower(email[i:])
+		}
+		if !seen[email] {
+			seen[email] = true
+			emails = append(emails, email)
+		}
+	}
+	return emails
+}
+
+// Returns the RSS document as nested maps (e.g. for rendering with
+// text/template).
+//
+// The map contains the attributes of <rss> (e.g. "version"), the metadata of
+// <channel> under "channel", and the <item>s of <channel> under "items".
+// Elements and attributes are keyed by name (e.g. "title" or "isPermaLink",
+// and "atom:link" for <atom:link> or "dc:date" for <dc:date>); absent ones
+// are omitted. An element with
+// neither attributes nor sub-elements is a string containing its character
+// data, otherwise it is a map with any character data under "text". Repeated
+// elements (e.g. <category>) are slices.
+func (r *RSS) ToMap() map[string]any {
+	m := map[string]any{"items": []any{}}
+	if v, ok := toMapValue(reflect.ValueOf(r)); ok {
+		if v, ok := v.(map[string]any); ok {
+			for k, e := range v {
+				m[k] = e
+			}
+		}
+	}
+	if c, ok := m["channel"].(map[string]any); ok {
+		if items, ok := c["item"]; ok {
+			m["items"] = items
+			delete(c, "item")
+		}
+	}
+	return m
+}
+
+// The conventional prefixes of the namespaces of extension elements (e.g.
+// "atom" for <atom:link>).
+var namespacePrefixes = map[string]string{
+	ATOMNAMESPACE:   "atom",
+	DCNAMESPACE:     "dc",
+	ITUNESNAMESPACE: "itunes",
+}
+
+// Returns the value of 'v' as described by ToMap and whether it is present.
+func toMapValue(v reflect.Value) (any, bool) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil, false
+		}
+		return toMapValue(v.Elem())
+	case reflect.String:
+		return v.String(), v.String() != ""
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// Integer elements (e.g. <hour>) are present even if zero.
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Slice:
+		// Character data ([]byte) contains no sub-elements.
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes()), v.Len() > 0
+		}
+		s := []any{}
+		for i := 0; i < v.Len(); i++ {
+			if e, ok := toMapValue(v.Index(i)); ok {
+				s = append(s, e)
+			}
+		}
+		return s, len(s) > 0
+	case reflect.Struct:
+		m := map[string]any{}
+		text := ""
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("xml")
+			switch {
+			case !f.IsExported() || f.Type == reflect.TypeOf(xml.Name{}) || tag == "-" || strings.Contains(tag, ",comment"):
+				continue
+			case strings.Contains(tag, ",chardata"):
+				text = string(v.Field(i).Bytes())
+			default:
+				if e, ok := toMapValue(v.Field(i)); ok {
+					space, name := tagName(f)
+					if prefix, ok := namespacePrefixes[space]; ok {
+						name = prefix + ":" + name
+					}
+					m[name] = e
+				}
+			}
+		}
+		if len(m) == 0 {
+			return text, text != ""
+		}
+		if text != "" {
+			m["text"] = text
+		}
+		return m, true
+	default:
+		return nil, false
+	}
+}
+
+// Calls 'fn' for each exported struct field of 'v' and, recursively, for each
+// exported struct field of its sub-elements. Pointers and slices are followed.
+// Zero-valued structs represent absent elements and are not descended into.
+//
+// If 'v' is addressable (e.g. obtained from a pointer), the values passed to
+// 'fn' are settable.
+func walk(v reflect.Value, fn func(f reflect.StructField, v reflect.Value)) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			walk(v.Elem(), fn)
+		}
+	case reflect.Slice:
+		// Character data ([]byte) contains no sub-elements.
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			walk(v.Index(i), fn)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			fn(t.Field(i), v.Field(i))
+			if v.Field(i).Kind() == reflect.Struct && v.Field(i).IsZero() {
+				continue
+			}
+			walk(v.Field(i), fn)
+		}
+	}
+}
+
+// Returns the namespace and local name of the element or attribute
+// represented by the struct field 'f', as specified by its "xml" tag (e.g.
+// `xml:"title"` or `xml:"http://www.w3.org/2005/Atom link"`). Fields that are
+// not marshaled (i.e. `xml:"-"`) have no name.
+func tagName(f reflect.StructField) (string, string) {
+	name := strings.Split(f.Tag.Get("xml"), ",")[0]
+	if name == "-" {
+		return "", ""
+	}
+	if i := strings.LastIndex(name, " "); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
@@ -0,0 +1,528 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRSSContentType(t *testing.T) {
+	t.Run("test content type", func(t *testing.T) {
+		var r RSS
+		assert.Equal(t, "application/rss+xml; charset=utf-8", r.ContentType())
+	})
+}
+
+func TestRSSPrune(t *testing.T) {
+	t.Run("test prune - empty attributes", func(t *testing.T) {
+		r := RSS{
+			Version: Version("2.0"),
+			Channel: &Channel{
+				Item: []*Item{
+					{
+						XMLName: xml.Name{Space: "", Local: "item"},
+						Title: &Title{
+							XMLName:  xml.Name{Space: "", Local: "title"},
+							CharData: []byte("Title"),
+						},
+						Category: []*Category{
+							{
+								XMLName:  xml.Name{Space: "", Local: "category"},
+								CharData: []byte("Category"),
+								Domain:   Ptr(""),
+							},
+						},
+						GUID: &GUID{
+							XMLName:     xml.Name{Space: "", Local: "guid"},
+							CharData:    []byte("https://example.com/1337"),
+							IsPermaLink: Ptr(IsPermaLink("")),
+						},
+					},
+				},
+			},
+		}
+		item := r.Channel.Item[0]
+		ret, errs := item.IsValid()
+		assert.False(t, ret)
+		assert.Equal(t, 2, len(errs))
+		r.Prune()
+		assert.Nil(t, item.Category[0].Domain)
+		assert.Nil(t, item.GUID.IsPermaLink)
+		ret, errs = item.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		s, err := xml.Marshal(item.Category[0])
+		assert.Equal(t, []byte(`<category>Category</category>`), s)
+		assert.Nil(t, err)
+		// Prune is idempotent.
+		r.Prune()
+		assert.Nil(t, item.Category[0].Domain)
+		assert.Nil(t, item.GUID.IsPermaLink)
+	})
+	t.Run("test prune - non-empty attributes", func(t *testing.T) {
+		r := RSS{
+			Channel: &Channel{
+				Item: []*Item{
+					{Category: []*Category{{CharData: []byte("Category"), Domain: Ptr("dmoz")}}},
+				},
+			},
+		}
+		r.Prune()
+		assert.Equal(t, "dmoz", *r.Channel.Item[0].Category[0].Domain)
+	})
+}
+
+func TestRSSNormalizeDates(t *testing.T) {
+	newRSS := func() *RSS {
+		return &RSS{Channel: &Channel{
+			PubDate:       &PubDate{CharData: []byte(" 01 Jan 70 00:00 GMT\n")},
+			LastBuildDate: &LastBuildDate{CharData: []byte("02 Jan 06 15:04 EST")},
+			Item: []*Item{
+				{PubDate: &PubDate{CharData: []byte("Thu, 01 Jan 1970 00:00:00 GMT")}},
+				{PubDate: &PubDate{CharData: []byte("31 Dec 68 23:59 GMT")}},
+				{PubDate: &PubDate{CharData: []byte(" yesterday ")}},
+				{},
+			},
+		}}
+	}
+	t.Run("test normalize dates", func(t *testing.T) {
+		r := newRSS()
+		r.NormalizeDates()
+		assert.Equal(t, "01 Jan 70 00:00 GMT", string(r.Channel.PubDate.CharData))
+		assert.Equal(t, "02 Jan 06 15:04 EST", string(r.Channel.LastBuildDate.CharData))
+		assert.Equal(t, "Thu, 01 Jan 1970 00:00:00 GMT", string(r.Channel.Item[0].PubDate.CharData))
+		assert.Equal(t, "yesterday", string(r.Channel.Item[2].PubDate.CharData))
+	})
+	t.Run("test normalize dates - four-digit years", func(t *testing.T) {
+		r := newRSS()
+		r.NormalizeDates(WithFourDigitYears())
+		assert.Equal(t, "Thu, 01 Jan 1970 00:00:00 GMT", string(r.Channel.PubDate.CharData))
+		assert.Equal(t, "Mon, 02 Jan 2006 15:04:00 EST", string(r.Channel.LastBuildDate.CharData))
+		assert.Equal(t, "Thu, 01 Jan 1970 00:00:00 GMT", string(r.Channel.Item[0].PubDate.CharData))
+		assert.Equal(t, "Mon, 31 Dec 2068 23:59:00 GMT", string(r.Channel.Item[1].PubDate.CharData))
+		assert.Equal(t, "yesterday", string(r.Channel.Item[2].PubDate.CharData))
+		for _, d := range []*PubDate{r.Channel.PubDate, r.Channel.Item[1].PubDate} {
+			ok, err := IsValidDate(string(d.CharData))
+			assert.True(t, ok)
+			assert.Nil(t, err)
+		}
+		// NormalizeDates is idempotent.
+		want := newRSS()
+		want.NormalizeDates(WithFourDigitYears())
+		r.NormalizeDates(WithFourDigitYears())
+		assert.Equal(t, want, r)
+	})
+	t.Run("test normalize dates - no channel", func(t *testing.T) {
+		r := &RSS{}
+		r.NormalizeDates(WithFourDigitYears())
+		assert.Nil(t, r.Channel)
+	})
+}
+
+func TestRSSEnsureXMLNames(t *testing.T) {
+	t.Run("test ensure xml names", func(t *testing.T) {
+		r := RSS{
+			Version: Version("2.0"),
+			Channel: &Channel{
+				Item: []*Item{
+					{
+						Title: &Title{CharData: []byte("Title")},
+						GUID:  &GUID{CharData: []byte("1337"), IsPermaLink: Ptr(IsPermaLink("false"))},
+						Enclosure: &Enclosure{
+							URL:    Ptr("https://example.com/audio.mp3"),
+							Length: Ptr("1337"),
+						},
+					},
+				},
+			},
+		}
+		r.EnsureXMLNames()
+		assert.Equal(t, xml.Name{Space: "", Local: "rss"}, r.XMLName)
+		assert.Equal(t, xml.Name{Space: "", Local: "channel"}, r.Channel.XMLName)
+		item := r.Channel.Item[0]
+		assert.Equal(t, xml.Name{Space: "", Local: "item"}, item.XMLName)
+		assert.Equal(t, xml.Name{Space: "", Local: "title"}, item.Title.XMLName)
+		assert.Equal(t, xml.Name{Space: "", Local: "guid"}, item.GUID.XMLName)
+		assert.Equal(t, xml.Name{Space: "", Local: "enclosure"}, item.Enclosure.XMLName)
+		s, err := xml.Marshal(item)
+		assert.Equal(t, []byte(`<item><title>Title</title>`+
+			`<enclosure url="https://example.com/audio.mp3" length="1337"></enclosure>`+
+			`<guid isPermaLink="false">1337</guid></item>`), s)
+		assert.Nil(t, err)
+		ret, errs := item.IsValid()
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorContains(t, errs[0], "Attribute 'type' of <enclosure> is required")
+	})
+	t.Run("test ensure xml names - existing", func(t *testing.T) {
+		r := RSS{XMLName: xml.Name{Space: "http://backend.userland.com/rss2", Local: "rss"}}
+		r.EnsureXMLNames()
+		assert.Equal(t, xml.Name{Space: "http://backend.userland.com/rss2", Local: "rss"}, r.XMLName)
+	})
+}
+
+func TestRSSStats(t *testing.T) {
+	t.Run("test stats", func(t *testing.T) {
+		r := RSS{
+			Channel: &Channel{
+				Item: []*Item{
+					{
+						Title:     &Title{CharData: []byte("1")},
+						Category:  []*Category{{CharData: []byte("News")}},
+						PubDate:   &PubDate{CharData: []byte("Tue, 03 Jun 2003 09:39:21 GMT")},
+						GUID:      &GUID{CharData: []byte("https://example.com/1")},
+						Enclosure: &Enclosure{Length: Ptr("1000")},
+					},
+					{
+						Title:     &Title{CharData: []byte("2")},
+						Category:  []*Category{{CharData: []byte("News")}},
+						PubDate:   &PubDate{CharData: []byte("Fri, 30 May 2003 11:06:42 GMT")},
+						Enclosure: &Enclosure{Length: Ptr("337")},
+					},
+					{
+						Title:    &Title{CharData: []byte("3")},
+						Category: []*Category{{CharData: []byte("Sports")}},
+						PubDate:  &PubDate{CharData: []byte("not a date")},
+						GUID:     &GUID{CharData: []byte("https://example.com/3")},
+					},
+				},
+			},
+		}
+		stats := r.Stats()
+		assert.Equal(t, 3, stats.Items)
+		assert.Equal(t, 2, stats.ItemsWithEnclosure)
+		assert.Equal(t, 2, stats.ItemsWithGUID)
+		assert.Equal(t, 2, stats.DistinctCategories)
+		assert.True(t, time.Date(2003, time.May, 30, 11, 6, 42, 0, time.UTC).Equal(stats.EarliestPubDate))
+		assert.True(t, time.Date(2003, time.June, 3, 9, 39, 21, 0, time.UTC).Equal(stats.LatestPubDate))
+		assert.Equal(t, int64(1337), stats.TotalEnclosureBytes)
+	})
+	t.Run("test stats - empty", func(t *testing.T) {
+		var r RSS
+		assert.Equal(t, FeedStats{}, r.Stats())
+	})
+}
+
+func TestRSSStripQueryParams(t *testing.T) {
+	newRSS := func() *RSS {
+		return &RSS{
+			Channel: &Channel{
+				Link: Link{CharData: []byte("https://example.com/?utm_source=rss")},
+				Item: []*Item{
+					{
+						Link:      &Link{CharData: []byte("https://example.com/post?id=5&utm_campaign=spring&utm_medium=rss#top")},
+						Enclosure: &Enclosure{URL: Ptr("https://example.com/audio.mp3?utm_source=rss")},
+						GUID:      &GUID{CharData: []byte("https://example.com/post?id=5&utm_campaign=spring")},
+					},
+				},
+			},
+		}
+	}
+	t.Run("test strip query params - explicit", func(t *testing.T) {
+		r := newRSS()
+		r.StripQueryParams("utm_campaign")
+		assert.Equal(t, "https://example.com/post?id=5&utm_medium=rss#top", string(r.Channel.Item[0].Link.CharData))
+		assert.Equal(t, "https://example.com/?utm_source=rss", string(r.Channel.Link.CharData))
+	})
+	t.Run("test strip query params - default", func(t *testing.T) {
+		r := newRSS()
+		r.StripQueryParams()
+		assert.Equal(t, "https://example.com/", string(r.Channel.Link.CharData))
+		assert.Equal(t, "https://example.com/post?id=5#top", string(r.Channel.Item[0].Link.CharData))
+		assert.Equal(t, "https://example.com/audio.mp3", *r.Channel.Item[0].Enclosure.URL)
+		// <guid> is not modified.
+		assert.Equal(t, "https://example.com/post?id=5&utm_campaign=spring", string(r.Channel.Item[0].GUID.CharData))
+	})
+	t.Run("test strip query params - no channel", func(t *testing.T) {
+		r := &RSS{}
+		r.StripQueryParams()
+		assert.Nil(t, r.Channel)
+	})
+	t.Run("test strip query params - absent links", func(t *testing.T) {
+		r := &RSS{Channel: &Channel{Item: []*Item{{Link: &Link{}}}}}
+		r.StripQueryParams()
+		assert.Nil(t, r.Channel.Link.CharData)
+		assert.Nil(t, r.Channel.Item[0].Link.CharData)
+	})
+}
+
+func TestRSSResolveLinks(t *testing.T) {
+	newRSS := func() *RSS {
+		return &RSS{
+			Channel: &Channel{
+				Link:  Link{CharData: []byte("https://example.com/blog/")},
+				Image: &Image{URL: Ptr("/logo.png"), Link: Link{CharData: []byte("/")}},
+				Item: []*Item{
+					{
+						Link:      &Link{CharData: []byte("posts/1")},
+						Comments:  &Comments{CharData: []byte("#comments")},
+						Enclosure: &Enclosure{URL: Ptr("https://cdn.example.com/audio.mp3")},
+						GUID:      &GUID{CharData: []byte("posts/1")},
+					},
+					{
+						Link:      &Link{CharData: []byte("//[bad")},
+						Enclosure: &Enclosure{URL: Ptr("audio.mp3")},
+					},
+				},
+			},
+		}
+	}
+	t.Run("test resolve links", func(t *testing.T) {
+		r := newRSS()
+		r.ResolveLinks()
+		assert.Equal(t, "https://example.com/blog/", string(r.Channel.Link.CharData))
+		assert.Equal(t, "https://example.com/logo.png", *r.Channel.Image.URL)
+		assert.Equal(t, "https://example.com/", string(r.Channel.Image.Link.CharData))
+		item := r.Channel.Item[0]
+		assert.Equal(t, "https://example.com/blog/posts/1", string(item.Link.CharData))
+		// URLs of <item> are resolved against its <link>.
+		assert.Equal(t, "https://example.com/blog/posts/1#comments", string(item.Comments.CharData))
+		assert.Equal(t, "https://cdn.example.com/audio.mp3", *item.Enclosure.URL)
+		// <guid> is not modified.
+		assert.Equal(t, "posts/1", string(item.GUID.CharData))
+		// A <link> of <item> that cannot be parsed is left unchanged, and URLs of
+		// <item> are resolved against the <link> of <channel>.
+		item = r.Channel.Item[1]
+		assert.Equal(t, "//[bad", string(item.Link.CharData))
+		assert.Equal(t, "https://example.com/blog/audio.mp3", *item.Enclosure.URL)
+	})
+	t.Run("test resolve links - relative channel link", func(t *testing.T) {
+		r := newRSS()
+		r.Channel.Link.CharData = []byte("/blog/")
+		r.ResolveLinks()
+		assert.Equal(t, "posts/1", string(r.Channel.Item[0].Link.CharData))
+		assert.Equal(t, "/logo.png", *r.Channel.Image.URL)
+	})
+	t.Run("test resolve links - no channel", func(t *testing.T) {
+		r := &RSS{}
+		r.ResolveLinks()
+		assert.Nil(t, r.Channel)
+	})
+	t.Run("test resolve links - absent links", func(t *testing.T) {
+		r := &RSS{Channel: &Channel{Link: Link{CharData: []byte("https://example.com/")}, Item: []*Item{{Link: &Link{}}}}}
+		r.ResolveLinks()
+		assert.Nil(t, r.Channel.Item[0].Link.CharData)
+	})
+}
+
+func TestRSSEqualIgnoringDates(t *testing.T) {
+	newRSS := func(date string) *RSS {
+		return &RSS{
+			Version: RSSVERSION,
+			Channel: &Channel{
+				Title:         Title{CharData: []byte("Title")},
+				LastBuildDate: &LastBuildDate{CharData: []byte(date)},
+				Item: []*Item{
+					{Title: &Title{CharData: []byte("Item")}, PubDate: &PubDate{CharData: []byte(date)}},
+				},
+			},
+		}
+	}
+	t.Run("test equal ignoring dates - dates differ", func(t *testing.T) {
+		a := newRSS("Mon, 02 Jan 2006 15:04:05 GMT")
+		b := newRSS("Tue, 03 Jan 2006 15:04:05 GMT")
+		assert.NotEqual(t, a, b)
+		assert.True(t, a.EqualIgnoringDates(b))
+	})
+	t.Run("test equal ignoring dates - missing date", func(t *testing.T) {
+		a := newRSS("Mon, 02 Jan 2006 15:04:05 GMT")
+		b := newRSS("Mon, 02 Jan 2006 15:04:05 GMT")
+		b.Channel.Item[0].PubDate = nil
+		assert.True(t, a.EqualIgnoringDates(b))
+	})
+	t.Run("test equal ignoring dates - content differs", func(t *testing.T) {
+		a := newRSS("Mon, 02 Jan 2006 15:04:05 GMT")
+		b := newRSS("Mon, 02 Jan 2006 15:04:05 GMT")
+		b.Channel.Item[0].Title.CharData = []byte("Other")
+		assert.False(t, a.EqualIgnoringDates(b))
+		b = newRSS("Mon, 02 Jan 2006 15:04:05 GMT")
+		b.Channel.Item = append(b.Channel.Item, &Item{})
+		assert.False(t, a.EqualIgnoringDates(b))
+	})
+	t.Run("test equal ignoring dates - enclosure with child", func(t *testing.T) {
+		data := `<rss version="2.0"><channel><title>Title</title><item>` +
+			`<enclosure url="https://example.com/a.mp3" length="1" type="audio/mpeg"><title>Child</title></enclosure>` +
+			`<pubDate>%s</pubDate></item></channel></rss>`
+		a, err := Parse(strings.NewReader(fmt.Sprintf(data, "Mon, 02 Jan 2006 15:04:05 GMT")))
+		assert.Nil(t, err)
+		b, err := Parse(strings.NewReader(fmt.Sprintf(data, "Tue, 03 Jan 2006 15:04:05 GMT")))
+		assert.Nil(t, err)
+		assert.True(t, a.EqualIgnoringDates(b))
+	})
+}
+
+func TestSameFeed(t *testing.T) {
+	newRSS := func(link, self string) *RSS {
+		r := &RSS{Channel: &Channel{Link: Link{CharData: []byte(link)}}}
+		if self != "" {
+			r.Channel.AtomLink = []*AtomLink{{Href: Ptr(self), Rel: Ptr("self")}}
+		}
+		return r
+	}
+	t.Run("test same feed - self links", func(t *testing.T) {
+		a := newRSS("https://example.com", "http://example.com/feed")
+		b := newRSS("https://example.com/blog", "https://Example.com/feed/")
+		assert.True(t, SameFeed(a, b))
+	})
+	t.Run("test same feed - links", func(t *testing.T) {
+		a := newRSS("http://example.com/feed", "")
+		b := newRSS("https://example.com/feed/", "")
+		assert.True(t, SameFeed(a, b))
+	})
+	t.Run("test same feed - different feeds", func(t *testing.T) {
+		a := newRSS("https://example.com", "https://example.com/feed")
+		b := newRSS("https://example.com", "https://example.com/comments/feed")
+		assert.False(t, SameFeed(a, b))
+		assert.False(t, SameFeed(newRSS("https://a.example.com", ""), newRSS("https://b.example.com", "")))
+	})
+	t.Run("test same feed - missing", func(t *testing.T) {
+		assert.False(t, SameFeed(newRSS("", ""), newRSS("", "")))
+		assert.False(t, SameFeed(&RSS{}, newRSS("https://example.com", "")))
+		assert.False(t, SameFeed(nil, nil))
+	})
+}
+
+func TestRSSToMap(t *testing.T) {
+	t.Run("test to map", func(t *testing.T) {
+		r := RSS{
+			Version: RSSVERSION,
+			Channel: &Channel{
+				Title:    Title{CharData: []byte("Title")},
+				Link:     Link{CharData: []byte("https://example.com")},
+				Language: Language("en-us"),
+				AtomLink: []*AtomLink{{Href: Ptr("https://example.com/rss.xml"), Rel: Ptr("self")}},
+				Item: []*Item{
+					{
+						Title:    &Title{CharData: []byte("Item 1")},
+						Category: []*Category{{CharData: []byte("News")}, {CharData: []byte("Go"), Domain: Ptr("dmoz")}},
+						GUID:     &GUID{CharData: []byte("1337"), IsPermaLink: Ptr(IsPermaLink("false"))},
+					},
+					{Title: &Title{CharData: []byte("Item 2")}, DCDate: &DCDate{CharData: []byte("2003-06-03")}},
+				},
+			},
+		}
+		m := r.ToMap()
+		assert.Equal(t, "2.0", m["version"])
+		channel := m["channel"].(map[string]any)
+		assert.Equal(t, "Title", channel["title"])
+		assert.Equal(t, "https://example.com", channel["link"])
+		assert.Equal(t, "en-us", channel["language"])
+		assert.Equal(t, []any{map[string]any{"href": "https://example.com/rss.xml", "rel": "self"}}, channel["atom:link"])
+		assert.NotContains(t, channel, "description")
+		assert.NotContains(t, channel, "item")
+		items := m["items"].([]any)
+		assert.Len(t, items, 2)
+		assert.Equal(t, map[string]any{
+			"title":    "Item 1",
+			"category": []any{"News", map[string]any{"domain": "dmoz", "text": "Go"}},
+			"guid":     map[string]any{"isPermaLink": "false", "text": "1337"},
+		}, items[0])
+		assert.Equal(t, map[string]any{"title": "Item 2", "dc:date": "2003-06-03"}, items[1])
+	})
+	t.Run("test to map - skip hours", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(`<rss version="2.0"><channel>` +
+			`<skipHours><hour>0</hour><hour>23</hour></skipHours></channel></rss>`))
+		assert.Nil(t, err)
+		m := r.ToMap()
+		assert.Equal(t, map[string]any{"skipHours": map[string]any{"hour": []any{"0", "23"}}}, m["channel"])
+	})
+	t.Run("test to map - empty", func(t *testing.T) {
+		var r RSS
+		assert.Equal(t, map[string]any{"items": []any{}}, r.ToMap())
+	})
+}
+
+func TestRSSEmailAddresses(t *testing.T) {
+	t.Run("test email addresses", func(t *testing.T) {
+		r := RSS{
+			Channel: &Channel{
+				ManagingEditor: ManagingEditor("editor@Example.com (Editor)"),
+				WebMaster:      WebMaster("webmaster@example.com"),
+				Item: []*Item{
+					{Author: []*Author{{CharData: []byte("First.Last@EXAMPLE.com (First Last)")}, {CharData: []byte("not an address")}}},
+					{Author: []*Author{{CharData: []byte("editor@example.com")}}},
+					nil,
+					{},
+				},
+			},
+		}
+		assert.Equal(t, []string{"editor@example.com", "webmaster@example.com", "First.Last@example.com"}, r.EmailAddresses())
+	})
+	t.Run("test email addresses - itunes:owner", func(t *testing.T) {
+		r := RSS{
+			Channel: &Channel{
+				WebMaster:   WebMaster("webmaster@example.com"),
+				ITunesOwner: &ITunesOwner{Name: &ITunesName{CharData: []byte("Owner")}, Email: &ITunesEmail{CharData: []byte("owner@Example.com")}},
+				Item:        []*Item{{Author: []*Author{{CharData: []byte("webmaster@example.com")}}}},
+			},
+		}
+		assert.Equal(t, []string{"webmaster@example.com", "owner@example.com"}, r.EmailAddresses())
+		f, err := os.Open("test/data/samples/sample-podcast.xml")
+		assert.Nil(t, err)
+		defer f.Close()
+		p, err := Parse(f)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"editor@example.com"}, p.EmailAddresses())
+	})
+	t.Run("test email addresses - none", func(t *testing.T) {
+		assert.Empty(t, (&RSS{}).EmailAddresses())
+		assert.Empty(t, (&RSS{Channel: &Channel{}}).EmailAddresses())
+	})
+}
+
+func TestRSSContentHash(t *testing.T) {
+	newRSS := func() *RSS {
+		return &RSS{
+			Version: RSSVERSION,
+			Channel: &Channel{
+				Title:         Title{CharData: []byte("Title")},
+				Link:          Link{CharData: []byte("https://example.com")},
+				Description:   Description{CharData: []byte("Description")},
+				LastBuildDate: &LastBuildDate{CharData: []byte("Mon, 02 Jan 2006 15:04:05 GMT")},
+				Item: []*Item{
+					{Title: &Title{CharData: []byte("1")}, Link: &Link{CharData: []byte("https://example.com/1")}},
+					{Title: &Title{CharData: []byte("2")}, Link: &Link{CharData: []byte("https://example.com/2")}},
+				},
+			},
+		}
+	}
+	t.Run("test content hash - equal", func(t *testing.T) {
+		a, b := newRSS(), newRSS()
+		assert.Equal(t, a.ContentHash(), b.ContentHash())
+		assert.Len(t, a.ContentHash(), 64)
+		b.Channel.XMLComment = " generated by example "
+		assert.Equal(t, a.ContentHash(), b.ContentHash())
+	})
+	t.Run("test content hash - content changed", func(t *testing.T) {
+		a := newRSS()
+		b := newRSS()
+		b.Channel.Item[1].Title.CharData = []byte("Two")
+		assert.NotEqual(t, a.ContentHash(), b.ContentHash())
+		b = newRSS()
+		b.Channel.Description.CharData = []byte("Other")
+		assert.NotEqual(t, a.ContentHash(), b.ContentHash())
+		b = newRSS()
+		b.Channel.Item[0], b.Channel.Item[1] = b.Channel.Item[1], b.Channel.Item[0]
+		assert.NotEqual(t, a.ContentHash(), b.ContentHash())
+	})
+	t.Run("test content hash - last build date", func(t *testing.T) {
+		a := newRSS()
+		b := newRSS()
+		b.Channel.LastBuildDate.CharData = []byte("Tue, 03 Jan 2006 15:04:05 GMT")
+		assert.NotEqual(t, a.ContentHash(), b.ContentHash())
+		assert.Equal(t, a.ContentHash(WithoutLastBuildDate()), b.ContentHash(WithoutLastBuildDate()))
+		assert.Equal(t, "Tue, 03 Jan 2006 15:04:05 GMT", string(b.Channel.LastBuildDate.CharData))
+	})
+	t.Run("test content hash - empty", func(t *testing.T) {
+		assert.Equal(t, (&RSS{}).ContentHash(), (&RSS{}).ContentHash())
+	})
+}
@@ -0,0 +1,434 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Network functions for the rss package.
+package rss
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The User-Agent of requests made by this package, unless overridden with
+// WithUserAgent. It is of the form "<name>/<version> (+<url>)".
+const defaultUserAgent = "nickolashkraus-rss/" + PACKAGEVERSION + " (+https://github.com/nickolashkraus/rss)"
+
+// A FetchOption configures how requests are made.
+type FetchOption func(*fetchOptions)
+
+type fetchOptions struct {
+	userAgent string
+}
+
+// Sets the User-Agent header of requests. Some servers reject requests without
+// a recognizable User-Agent.
+func WithUserAgent(s string) FetchOption {
+	return func(o *fetchOptions) { o.userAgent = s }
+}
+
+// Sets the 'length' attribute of <enclosure> to the size of the resource in
+// bytes.
+//
+// The size is the Content-Length of an HTTP HEAD request to the enclosure URL.
+// Some servers (e.g. CDNs) do not report a Content-Length on HEAD, in which
+// case the size is taken from the Content-Range of a ranged GET request for
+// the first byte of the resource (Range: bytes=0-0).
+//
+// If 'client' is nil, http.DefaultClient is used. Requests are made with a
+// default User-Agent, unless overridden with WithUserAgent. On failure,
+// 'length' is left unchanged and the error is returned.
+func (r *Enclosure) FetchLength(ctx context.Context, client *http.Client, opts ...FetchOption) error {
+	if r.URL == nil {
+		msg := fmt.Sprintf("Attribute 'url' of <%s> is required", r.XMLName.Local)
+		return fmt.Errorf("%s: %w", msg, ErrInvalidElement)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	o := fetchOptions{userAgent: defaultUserAgent}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	length, err := headLength(ctx, client, *r.URL, o)
+	if errors.Is(err, ErrUnknownContentLength) {
+		length, err = rangeLength(ctx, client, *r.URL, o)
+	}
+	if err != nil {
+		return err
+	}
+	l := strconv.FormatInt(length, 10)
+	r.Length = &l
+	return nil
+}
+
+// Returns the Content-Length of an HTTP HEAD request to 'url'.
+func headLength(ctx context.Context, client *http.Client, url string, o fetchOptions) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", o.userAgent)
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("HEAD %s: %w: %s", url, ErrUnexpectedStatus, resp.Status)
+	}
+	if resp.ContentLength < 0 {
+		return 0, fmt.Errorf("HEAD %s: %w", url, ErrUnknownContentLength)
+	}
+	return resp.ContentLength, nil
+}
+
+// Returns the complete length of the resource at 'url' from the Content-Range
+// (e.g. "bytes 0-0/12345") of an HTTP GET request for its first byte.
+//
+// If the server ignores the Range header and responds with the entire
+// resource, its Content-Length is returned instead. The response body is not
+// read.
+func rangeLength(ctx context.Context, client *http.Client, url string, o fetchOptions) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", o.userAgent)
+	req.Header.Set("Range", "bytes=0-0")
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		cr := resp.Header.Get("Content-Range")
+		i := strings.LastIndex(cr, "/")
+		if i < 0 {
+			return 0, fmt.Errorf("GET %s: %w: Content-Range '%s'", url, ErrUnknownContentLength, cr)
+		}
+		n, err := strconv.ParseInt(cr[i+1:], 10, 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("GET %s: %w: Content-Range '%s'", url, ErrUnknownContentLength, cr)
+		}
+		return n, nil
+	case http.StatusOK:
+		if resp.ContentLength < 0 {
+			return 0, fmt.Errorf("GET %s: %w", url, ErrUnknownContentLength)
+		}
+		return resp.ContentLength, nil
+	default:
+		return 0, fmt.Errorf("GET %s: %w: %s", url, ErrUnexpectedStatus, resp.Status)
+	}
+}
+
+// Issues an HTTP HEAD request to the <link> of each <item> of <channel> and
+// returns the status code of each response, keyed by URL. If a request fails
+// (e.g. a network error), its status code is -1.
+//
+// At most 'concurrency' requests are made at a time. If 'client' is nil,
+// http.DefaultClient is used. Cancelling 'ctx' cancels outstanding requests.
+//
+// Unlike IsValid, which checks that <link> is a syntactically valid URI, this
+// checks that it is reachable.
+func (r *Channel) CheckLinks(ctx context.Context, client *http.Client, concurrency int, opts ...FetchOption) map[string]int {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	o := fetchOptions{userAgent: defaultUserAgent}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	links := []string{}
+	seen := map[string]bool{}
+	for _, item := range r.Item {
+		if item == nil || item.Link == nil || len(item.Link.CharData) == 0 {
+			continue
+		}
+		if l := string(item.Link.CharData); !seen[l] {
+			seen[l] = true
+			links = append(links, l)
+		}
+	}
+	statuses := make(map[string]int, len(links))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, l := range links {
+		wg.Add(1)
+		go func(l string) {
+			defer wg.Done()
+			status := -1
+			select {
+			case sem <- struct{}{}:
+				status = headStatus(ctx, client, l, o)
+				<-sem
+			case <-ctx.Done():
+			}
+			mu.Lock()
+			statuses[l] = status
+			mu.Unlock()
+		}(l)
+	}
+	wg.Wait()
+	return statuses
+}
+
+// Returns the status code of an HTTP HEAD request to 'url' or -1 if the
+// request fails.
+func headStatus(ctx context.Context, client *http.Client, url string, o fetchOptions) int {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return -1
+	}
+	req.Header.Set("User-Agent", o.userAgent)
+	resp, err := client.Do(req)
+	if err != nil {
+		return -1
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+// The relative difference between the declared and actual length of an
+// enclosure that is tolerated by VerifyEnclosureLengths.
+const enclosureLengthTolerance = 0.01
+
+// Verifies the 'length' attribute of the <enclosure> of each <item> of
+// <channel> against the size of the resource (see Enclosure.FetchLength) and
+// returns an error for each enclosure whose length differs by more than 1%,
+// or whose size cannot be determined.
+//
+// Enclosures with a length of zero (i.e. unknown) are skipped. If 'client' is
+// nil, http.DefaultClient is used.
+//
+// This is an integration check, separate from IsValid.
+func (r *Channel) VerifyEnclosureLengths(ctx context.Context, client *http.Client, opts ...FetchOption) []error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	o := fetchOptions{userAgent: defaultUserAgent}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	errs := []error{}
+	for i, item := range r.Item {
+		if item == nil || item.Enclosure == nil || item.Enclosure.URL == nil || item.Enclosure.Length == nil {
+			continue
+		}
+		e := item.Enclosure
+		declared, err := strconv.ParseInt(*e.Length, 10, 64)
+		if err != nil || declared <= 0 {
+			continue
+		}
+		path := fmt.Sprintf("channel > item[%d] > enclosure", i)
+		actual, err := headLength(ctx, client, *e.URL, o)
+		if errors.Is(err, ErrUnknownContentLength) {
+			actual, err = rangeLength(ctx, client, *e.URL, o)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+		if math.Abs(float64(declared-actual)) > enclosureLengthTolerance*float64(actual) {
+			msg := fmt.Sprintf("Attribute 'length' of <%s> value '%s' is invalid", e.XMLName.Local, *e.Length)
+			errs = append(errs, fmt.Errorf("%s: %s: %w: must match the size of '%s' (%d bytes)", path, msg, ErrInvalidValue, *e.URL, actual))
+		}
+	}
+	return errs
+}
+
+// Fetches the RSS document at 'firstURL' and follows its <atom:link
+// rel="next"> links to subsequent pages, up to 'maxPages' pages in total.
+// Returns the first page with the <item>s of all pages appended to its
+// <channel>, in page order.
+//
+// <item>s with the same <guid> as an earlier <item> are omitted. A link to a
+// page that has already been fetched ends pagination. If 'client' is nil,
+// http.DefaultClient is used. An error is returned if 'firstURL' is empty or
+// 'maxPages' is less than one.
+//
+// See: https://www.rfc-editor.org/rfc/rfc5005#section-3
+func FetchPaginated(ctx context.Context, client *http.Client, firstURL string, maxPages int, opts ...FetchOption) (*RSS, error) {
+	if firstURL == "" {
+		return nil, fmt.Errorf("URL '%s' is invalid: %w", firstURL, ErrInvalidURI)
+	}
+	if maxPages < 1 {
+		return nil, fmt.Errorf("Max pages '%d' is invalid: %w: must be a positive integer", maxPages, ErrInvalidValue)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	o := fetchOptions{userAgent: defaultUserAgent}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var first *RSS
+	items := []*Item{}
+	guids := map[string]bool{}
+	visited := map[string]bool{}
+	for u := firstURL; u != "" && len(visited) < maxPages && !visited[u]; {
+		visited[u] = true
+		page, err := fetchFeed(ctx, client, u, o)
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = page
+		}
+		if page.Channel == nil {
+			break
+		}
+		for _, item := range page.Channel.Item {
+			if item != nil && item.GUID != nil {
+				guid := string(item.GUID.CharData)
+				if guids[guid] {
+					continue
+				}
+				guids[guid] = true
+			}
+			items = append(items, item)
+		}
+		next, ok := page.Channel.LinkByRel("next")
+		if !ok {
+			break
+		}
+		base, err := url.Parse(u)
+		if err != nil {
+			break
+		}
+		ref, err := url.Parse(next)
+		if err != nil {
+			break
+		}
+		u = base.ResolveReference(ref).String()
+	}
+	if first != nil && first.Channel != nil {
+		first.Channel.Item = items
+	}
+	return first, nil
+}
+
+// Returns the RSS document parsed from the response to an HTTP GET request to
+// 'url'.
+func fetchFeed(ctx context.Context, client *http.Client, url string, o fetchOptions) (*RSS, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", o.userAgent)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %w: %s", url, ErrUnexpectedStatus, resp.Status)
+	}
+	r, err := Parse(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("GET %s: %w", url, err)
+	}
+	return r, nil
+}
+
+// Returns a copy of 'base' that limits the rate of requests made to each host.
+//
+// Requests to a host are limited by a token bucket, which holds at most 'burst'
+// tokens and is refilled at 'perHost' tokens per second. Each request takes a
+// token, waiting until one is available or the context of the request is
+// cancelled. Requests to different hosts (e.g. CheckLinks of a channel whose
+// items link to several sites) are limited independently.
+//
+// If 'base' is nil, http.DefaultClient is copied. If 'perHost' is not positive,
+// requests are not limited. If 'burst' is less than 1, a burst of 1 is used.
+func NewRateLimitedClient(base *http.Client, perHost float64, burst int) *http.Client {
+	if base == nil {
+		base = http.DefaultClient
+	}
+	c := *base
+	if perHost <= 0 {
+		return &c
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	next := c.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c.Transport = &rateLimitedTransport{
+		next:    next,
+		rate:    perHost,
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+	}
+	return &c
+}
+
+// An http.RoundTripper that limits the rate of requests to each host.
+type rateLimitedTransport struct {
+	next    http.RoundTripper
+	rate    float64
+	burst   float64
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.wait(req.Context(), req.URL.Host); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
+
+// Takes a token from the bucket of 'host', waiting until it is available.
+//
+// The token is reserved before waiting, so concurrent requests to a host are
+// spaced rather than released at once. If 'ctx' is cancelled while waiting,
+// the token is returned to the bucket.
+func (t *rateLimitedTransport) wait(ctx context.Context, host string) error {
+	t.mu.Lock()
+	now := time.Now()
+	b, ok := t.buckets[host]
+	if !ok {
+		b = &tokenBucket{tokens: t.burst, last: now}
+		t.buckets[host] = b
+	}
+	b.tokens = math.Min(t.burst, b.tokens+now.Sub(b.last).Seconds()*t.rate)
+	b.last = now
+	b.tokens--
+	delay := time.Duration(-b.tokens / t.rate * float64(time.Second))
+	t.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		t.mu.Lock()
+		b.tokens++
+		t.mu.Unlock()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnclosureFetchLength(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/audio.mp3":
+			assert.Equal(t, http.MethodHead, r.Method)
+			w.Header().Set("Content-Length", "12345")
+		case "/cdn.mp3":
+			// No Content-Length on HEAD, but a ranged GET reports the
+			// complete length in Content-Range.
+			if r.Method == http.MethodGet {
+				assert.Equal(t, "bytes=0-0", r.Header.Get("Range"))
+				w.Header().Set("Content-Range", "bytes 0-0/12345")
+				w.WriteHeader(http.StatusPartialContent)
+				w.Write([]byte{0})
+			}
+		case "/unknown.mp3":
+			if r.Method == http.MethodGet {
+				w.Header().Set("Content-Range", "bytes 0-0/*")
+				w.WriteHeader(http.StatusPartialContent)
+				w.Write([]byte{0})
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	t.Run("test fetch length - ok", func(t *testing.T) {
+		r := Enclosure{URL: Ptr(ts.URL + "/audio.mp3")}
+		err := r.FetchLength(context.Background(), ts.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, "12345", *r.Length)
+	})
+	t.Run("test fetch length - ok - content range", func(t *testing.T) {
+		r := Enclosure{URL: Ptr(ts.URL + "/cdn.mp3")}
+		err := r.FetchLength(context.Background(), ts.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, "12345", *r.Length)
+	})
+	t.Run("test fetch length - fail - unknown length", func(t *testing.T) {
+		r := Enclosure{URL: Ptr(ts.URL + "/unknown.mp3")}
+		err := r.FetchLength(context.Background(), ts.Client())
+		assert.ErrorIs(t, err, ErrUnknownContentLength)
+		assert.Nil(t, r.Length)
+	})
+	t.Run("test fetch length - fail - not found", func(t *testing.T) {
+		r := Enclosure{URL: Ptr(ts.URL + "/missing.mp3")}
+		err := r.FetchLength(context.Background(), ts.Client())
+		assert.ErrorIs(t, err, ErrUnexpectedStatus)
+		assert.Nil(t, r.Length)
+	})
+	t.Run("test fetch length - fail - missing url", func(t *testing.T) {
+		var r Enclosure
+		err := r.FetchLength(context.Background(), ts.Client())
+		assert.ErrorIs(t, err, ErrInvalidElement)
+		assert.Nil(t, r.Length)
+	})
+}
+
+func TestEnclosureFetchLengthUserAgent(t *testing.T) {
+	var userAgent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Length", "12345")
+	}))
+	defer ts.Close()
+	t.Run("test fetch length - user agent - default", func(t *testing.T) {
+		r := Enclosure{URL: Ptr(ts.URL + "/audio.mp3")}
+		err := r.FetchLength(context.Background(), ts.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, "nickolashkraus-rss/"+PACKAGEVERSION+" (+https://github.com/nickolashkraus/rss)", userAgent)
+		assert.Regexp(t, `^nickolashkraus-rss/\d+\.\d+\.\d+ \(\+https://github\.com/nickolashkraus/rss\)$`, userAgent)
+	})
+	t.Run("test fetch length - user agent - custom", func(t *testing.T) {
+		r := Enclosure{URL: Ptr(ts.URL + "/audio.mp3")}
+		err := r.FetchLength(context.Background(), ts.Client(), WithUserAgent("example/1.0"))
+		assert.Nil(t, err)
+		assert.Equal(t, "example/1.0", userAgent)
+	})
+}
+
+func TestChannelCheckLinks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodHead, r.Method)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	r := Channel{
+		Item: []*Item{
+			{Link: &Link{CharData: []byte(ts.URL + "/ok")}},
+			{Link: &Link{CharData: []byte(ts.URL + "/missing")}},
+			{Link: &Link{CharData: []byte("http://127.0.0.1:0/unreachable")}},
+			{Title: &Title{CharData: []byte("No link")}},
+		},
+	}
+	t.Run("test check links", func(t *testing.T) {
+		statuses := r.CheckLinks(context.Background(), ts.Client(), 2)
+		assert.Equal(t, map[string]int{
+			ts.URL + "/ok":                   http.StatusOK,
+			ts.URL + "/missing":              http.StatusNotFound,
+			"http://127.0.0.1:0/unreachable": -1,
+		}, statuses)
+	})
+	t.Run("test check links - cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		statuses := r.CheckLinks(ctx, ts.Client(), 1)
+		assert.Len(t, statuses, 3)
+		for _, status := range statuses {
+			assert.Equal(t, -1, status)
+		}
+	})
+}
+
+func TestChannelVerifyEnclosureLengths(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/audio.mp3":
+			w.Header().Set("Content-Length", "100000")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	enclosure := func(path, length string) *Enclosure {
+		return &Enclosure{
+			XMLName: xml.Name{Space: "", Local: "enclosure"},
+			URL:     Ptr(ts.URL + path),
+			Length:  Ptr(length),
+			Type:    Ptr("audio/mpeg"),
+		}
+	}
+	t.Run("test verify enclosure lengths", func(t *testing.T) {
+		r := Channel{
+			Item: []*Item{
+				{Enclosure: enclosure("/audio.mp3", "100000")},
+				{Enclosure: enclosure("/audio.mp3", "99500")},
+				{Enclosure: enclosure("/audio.mp3", "1337")},
+				{Enclosure: enclosure("/audio.mp3", "0")},
+				{Enclosure: enclosure("/missing.mp3", "1337")},
+				{},
+			},
+		}
+		errs := r.VerifyEnclosureLengths(context.Background(), ts.Client())
+		assert.Len(t, errs, 2)
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		assert.ErrorContains(t, errs[0], "channel > item[2] > enclosure: Attribute 'length' of <enclosure> value '1337' is invalid")
+		assert.ErrorIs(t, errs[1], ErrUnexpectedStatus)
+		assert.ErrorContains(t, errs[1], "channel > item[4] > enclosure: ")
+	})
+}
+
+func TestFetchPaginated(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/page1":
+			w.Write([]byte(`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Title</title>` +
+				`<atom:link href="/page2" rel="next"/>` +
+				`<item><guid>https://example.com/1</guid></item><item><guid>https://example.com/2</guid></item>` +
+				`</channel></rss>`))
+		case "/page2":
+			w.Write([]byte(`<rss version="2.0"><channel><title>Page 2</title>` +
+				`<item><guid>https://example.com/2</guid></item><item><guid>https://example.com/3</guid></item>` +
+				`</channel></rss>`))
+		case "/loop":
+			w.Write([]byte(`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Loop</title>` +
+				`<atom:link href="/loop" rel="next"/><item><guid>https://example.com/1</guid></item></channel></rss>`))
+		case "/broken":
+			w.Write([]byte(`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Broken</title>` +
+				`<atom:link href="/missing" rel="next"/></channel></rss>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	guids := func(r *RSS) []string {
+		s := []string{}
+		for _, item := range r.Channel.Item {
+			s = append(s, string(item.GUID.CharData))
+		}
+		return s
+	}
+	t.Run("test fetch paginated", func(t *testing.T) {
+		r, err := FetchPaginated(context.Background(), ts.Client(), ts.URL+"/page1", 10)
+		assert.Nil(t, err)
+		assert.Equal(t, "Title", r.Channel.TitleString())
+		assert.Equal(t, []string{"https://example.com/1", "https://example.com/2", "https://example.com/3"}, guids(r))
+	})
+	t.Run("test fetch paginated - max pages", func(t *testing.T) {
+		r, err := FetchPaginated(context.Background(), ts.Client(), ts.URL+"/page1", 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"https://example.com/1", "https://example.com/2"}, guids(r))
+	})
+	t.Run("test fetch paginated - loop", func(t *testing.T) {
+		r, err := FetchPaginated(context.Background(), ts.Client(), ts.URL+"/loop", 10)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"https://example.com/1"}, guids(r))
+	})
+	t.Run("test fetch paginated - fail - missing page", func(t *testing.T) {
+		r, err := FetchPaginated(context.Background(), ts.Client(), ts.URL+"/broken", 10)
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, ErrUnexpectedStatus)
+		assert.ErrorContains(t, err, ts.URL+"/missing")
+	})
+	t.Run("test fetch paginated - fail - empty url", func(t *testing.T) {
+		r, err := FetchPaginated(context.Background(), nil, "", 3)
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, ErrInvalidURI)
+	})
+	t.Run("test fetch paginated - fail - zero pages", func(t *testing.T) {
+		r, err := FetchPaginated(context.Background(), ts.Client(), ts.URL+"/page1", 0)
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, ErrInvalidValue)
+	})
+}
+
+func TestNewRateLimitedClient(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	ts1 := httptest.NewServer(handler)
+	defer ts1.Close()
+	ts2 := httptest.NewServer(handler)
+	defer ts2.Close()
+	get := func(t *testing.T, ctx context.Context, client *http.Client, url string) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		assert.Nil(t, err)
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	}
+	t.Run("test rate limited client - same host", func(t *testing.T) {
+		client := NewRateLimitedClient(ts1.Client(), 5, 1)
+		start := time.Now()
+		assert.Nil(t, get(t, context.Background(), client, ts1.URL))
+		assert.Nil(t, get(t, context.Background(), client, ts1.URL))
+		assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	})
+	t.Run("test rate limited client - different hosts", func(t *testing.T) {
+		client := NewRateLimitedClient(ts1.Client(), 1, 1)
+		start := time.Now()
+		assert.Nil(t, get(t, context.Background(), client, ts1.URL))
+		assert.Nil(t, get(t, context.Background(), client, ts2.URL))
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+	t.Run("test rate limited client - burst", func(t *testing.T) {
+		client := NewRateLimitedClient(ts1.Client(), 1, 2)
+		start := time.Now()
+		assert.Nil(t, get(t, context.Background(), client, ts1.URL))
+		assert.Nil(t, get(t, context.Background(), client, ts1.URL))
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+	t.Run("test rate limited client - fail - cancelled", func(t *testing.T) {
+		client := NewRateLimitedClient(ts1.Client(), 0.1, 1)
+		assert.Nil(t, get(t, context.Background(), client, ts1.URL))
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, get(t, ctx, client, ts1.URL), context.DeadlineExceeded)
+	})
+	t.Run("test rate limited client - fetch", func(t *testing.T) {
+		client := NewRateLimitedClient(ts1.Client(), 5, 1)
+		r := Enclosure{URL: Ptr(ts1.URL)}
+		assert.Nil(t, r.FetchLength(context.Background(), client))
+		assert.Equal(t, "0", *r.Length)
+	})
+}
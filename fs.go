@@ -0,0 +1,138 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// File system functions for the rss package.
+package rss
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/fs"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// Returns a read-only file system containing a file for each <item> of
+// <channel>, whose content is the marshaled <item>.
+//
+// Files are in the root directory and are named by the <guid> of the item,
+// escaped so that it is a valid file name (e.g.
+// "https:%2F%2Fexample.com%2F1.xml"), or, if it has no <guid>, the hash of
+// its content (see Item.Hash). If several items have the same name, only the
+// first is included.
+//
+// The file system is a snapshot: later changes to <channel> are not
+// reflected.
+func (r *Channel) FS() fs.FS {
+	f := &itemFS{files: map[string][]byte{}}
+	for _, item := range r.Item {
+		if item == nil {
+			continue
+		}
+		name := item.Hash()
+		if item.GUID != nil && len(item.GUID.CharData) > 0 {
+			name = url.PathEscape(string(item.GUID.CharData))
+		}
+		name += ".xml"
+		if _, ok := f.files[name]; ok {
+			continue
+		}
+		b, err := xml.Marshal(item)
+		if err != nil {
+			continue
+		}
+		f.files[name] = b
+		f.names = append(f.names, name)
+	}
+	sort.Strings(f.names)
+	return f
+}
+
+// An itemFS is a file system containing the marshaled <item>s of <channel>.
+type itemFS struct {
+	files map[string][]byte
+	names []string // sorted
+}
+
+func (f *itemFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		return &itemDir{fs: f}, nil
+	}
+	b, ok := f.files[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return &itemFile{Reader: bytes.NewReader(b), info: itemFileInfo{name: name, size: int64(len(b))}}, nil
+}
+
+// An itemFile is an open file of an itemFS.
+type itemFile struct {
+	*bytes.Reader
+	info itemFileInfo
+}
+
+func (f *itemFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+
+func (f *itemFile) Close() error { return nil }
+
+// An itemDir is the open root directory of an itemFS.
+type itemDir struct {
+	fs     *itemFS
+	offset int // number of entries returned by ReadDir
+}
+
+func (d *itemDir) Stat() (fs.FileInfo, error) { return itemFileInfo{name: ".", dir: true}, nil }
+
+func (d *itemDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: ".", Err: fs.ErrInvalid}
+}
+
+func (d *itemDir) Close() error { return nil }
+
+func (d *itemDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	names := d.fs.names[d.offset:]
+	if n > 0 {
+		if len(names) == 0 {
+			return nil, io.EOF
+		}
+		if n < len(names) {
+			names = names[:n]
+		}
+	}
+	entries := make([]fs.DirEntry, len(names))
+	for i, name := range names {
+		entries[i] = fs.FileInfoToDirEntry(itemFileInfo{name: name, size: int64(len(d.fs.files[name]))})
+	}
+	d.offset += len(names)
+	return entries, nil
+}
+
+// An itemFileInfo describes a file or the root directory of an itemFS.
+type itemFileInfo struct {
+	name string
+	size int64
+	dir  bool
+}
+
+func (i itemFileInfo) Name() string { return i.name }
+
+func (i itemFileInfo) Size() int64 { return i.size }
+
+func (i itemFileInfo) Mode() fs.FileMode {
+	if i.dir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+func (i itemFileInfo) ModTime() time.Time { return time.Time{} }
+
+func (i itemFileInfo) IsDir() bool { return i.dir }
+
+func (i itemFileInfo) Sys() any { return nil }
@@ -0,0 +1,70 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"encoding/xml"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelFS(t *testing.T) {
+	first := &Item{Title: &Title{CharData: []byte("First")}}
+	r := Channel{
+		Item: []*Item{
+			{
+				XMLName: xml.Name{Space: "", Local: "item"},
+				Title:   &Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+				GUID:    &GUID{XMLName: xml.Name{Space: "", Local: "guid"}, CharData: []byte("https://example.com/1")},
+			},
+			first,
+			// Same <guid> as the first item.
+			{Title: &Title{CharData: []byte("Other")}, GUID: &GUID{CharData: []byte("https://example.com/1")}},
+			nil,
+		},
+	}
+	guidName := "https:%2F%2Fexample.com%2F1.xml"
+	hashName := first.Hash() + ".xml"
+	t.Run("test fs - conformance", func(t *testing.T) {
+		assert.Nil(t, fstest.TestFS(r.FS(), guidName, hashName))
+	})
+	t.Run("test fs - walk", func(t *testing.T) {
+		fsys := r.FS()
+		names := []string{}
+		err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() {
+				names = append(names, path)
+			}
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{guidName, hashName}, names)
+	})
+	t.Run("test fs - read", func(t *testing.T) {
+		b, err := fs.ReadFile(r.FS(), guidName)
+		assert.Nil(t, err)
+		assert.Equal(t, `<item><title>Title</title><guid>https://example.com/1</guid></item>`, string(b))
+		var item Item
+		assert.Nil(t, xml.Unmarshal(b, &item))
+		assert.Equal(t, "Title", string(item.Title.CharData))
+	})
+	t.Run("test fs - fail - not found", func(t *testing.T) {
+		_, err := fs.ReadFile(r.FS(), "missing.xml")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+		_, err = r.FS().Open("../" + guidName)
+		assert.ErrorIs(t, err, fs.ErrInvalid)
+	})
+	t.Run("test fs - empty", func(t *testing.T) {
+		entries, err := fs.ReadDir((&Channel{}).FS(), ".")
+		assert.Nil(t, err)
+		assert.Empty(t, entries)
+	})
+}
@@ -0,0 +1,371 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Helper methods for <item>.
+package rss
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"mime"
+	"net/mail"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// MIME types of common media file extensions. These take precedence over the
+// system MIME type table, which often lacks audio and video types.
+var mediaTypes = map[string]string{
+	".aac":  "audio/aac",
+	".epub": "application/epub+zip",
+	".flac": "audio/flac",
+	".m4a":  "audio/mp4",
+	".m4b":  "audio/mp4",
+	".m4v":  "video/x-m4v",
+	".mov":  "video/quicktime",
+	".mp3":  "audio/mpeg",
+	".mp4":  "video/mp4",
+	".oga":  "audio/ogg",
+	".ogg":  "audio/ogg",
+	".opus": "audio/opus",
+	".pdf":  "application/pdf",
+	".wav":  "audio/wav",
+	".webm": "video/webm",
+}
+
+// Returns the MIME type inferred from the extension of 's' or an empty string
+// if it cannot be inferred.
+func inferMediaType(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+	ext := strings.ToLower(path.Ext(u.Path))
+	if ext == "" {
+		return ""
+	}
+	if t, ok := mediaTypes[ext]; ok {
+		return t
+	}
+	return mime.TypeByExtension(ext)
+}
+
+// Sets the <enclosure> of <item>.
+//
+// If 'mimeType' is empty, the MIME type is inferred from the extension of
+// 'url'. An error is returned if 'url' is not a valid URI, 'length' is
+// negative, or the MIME type cannot be inferred.
+func (r *Item) SetEnclosure(url string, length int64, mimeType string) error {
+	msg := fmt.Sprintf("Attribute 'url' of <enclosure> value '%s' is invalid", url)
+	if ok, err := IsNotEmpty(url); !ok {
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+	if ok, err := IsValidURI(url); !ok {
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+	if length < 0 {
+		msg := fmt.Sprintf("Attribute 'length' of <enclosure> value '%d' is invalid", length)
+		return fmt.Errorf("%s: %w: must be a positive integer", msg, ErrInvalidValue)
+	}
+	if mimeType == "" {
+		if mimeType = inferMediaType(url); mimeType == "" {
+			msg := fmt.Sprintf("Attribute 'type' of <enclosure> could not be inferred from '%s'", url)
+			return fmt.Errorf("%s: %w", msg, ErrInvalidValue)
+		}
+	}
+	l := strconv.FormatInt(length, 10)
+	r.Enclosure = &Enclosure{
+		XMLName: xml.Name{Space: "", Local: "enclosure"},
+		URL:     &url,
+		Length:  &l,
+		Type:    &mimeType,
+	}
+	return nil
+}
+
+// Returns the best available author of <item> for display.
+//
+// The author is taken from the first non-empty source of:
+//   - <author>s of <item>, in order
+//   - <dc:creator> of <item>
+//   - <itunes:author> of <item>
+//   - <managingEditor> of <channel>
+//
+// <author> and <managingEditor> contain an email address, optionally followed
+// by the name of the author in parentheses (e.g. "first.last@example.com
+// (First Last)"). If a name is present it is returned, otherwise the address
+// is returned. <dc:creator> and <itunes:author> contain a name, which is
+// returned as is.
+func (r *Item) AuthorDisplay(c *Channel) string {
+	for _, author := range r.Author {
+		if author == nil {
+			continue
+		}
+		if s := displayName(string(author.CharData)); s != "" {
+			return s
+		}
+	}
+	if r.DCCreator != nil {
+		if s := strings.TrimSpace(string(r.DCCreator.CharData)); s != "" {
+			return s
+		}
+	}
+	if r.ITunesAuthor != nil {
+		if s := strings.TrimSpace(string(r.ITunesAuthor.CharData)); s != "" {
+			return s
+		}
+	}
+	if c != nil {
+		if s := displayName(string(c.ManagingEditor)); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// Returns the mail addresses of the <author>s of <item>, in order (e.g.
+// "first.last@example.com" for "first.last@example.com (First Last)").
+//
+// Authors that do not contain a valid mail address are omitted.
+func (r *Item) AuthorEmails() []string {
+	emails := []string{}
+	for _, author := range r.Author {
+		if author == nil {
+			continue
+		}
+		a, err := mail.ParseAddress(strings.TrimSpace(string(author.CharData)))
+		if err != nil {
+			continue
+		}
+		emails = append(emails, a.Address)
+	}
+	return emails
+}
+
+// Returns the name part of the mail address 's', if present, otherwise the
+// address itself.
+func displayName(s string) string {
+	s = strings.TrimSpace(s)
+	a, err := mail.ParseAddress(s)
+	if err != nil {
+		return s
+	}
+	if a.Name != "" {
+		return a.Name
+	}
+	return a.Address
+}
+
+// Sets the <guid> of <item> to the permalink of the item, which is the <link>
+// of the item resolved against 'base' (e.g. "/post/1" against
+// "https://example.com" is "https://example.com/post/1").
+//
+// An error is returned if <item> does not contain a <link> or the resolved
+// link is not a valid absolute URI.
+func (r *Item) SetPermalinkGUID(base string) error {
+	if r.Link == nil {
+		msg := fmt.Sprintf("Element <%s> is invalid", r.XMLName.Local)
+		return fmt.Errorf("%s: %w: <link> must be present", msg, ErrInvalidElement)
+	}
+	msg := fmt.Sprintf("Element <guid> value '%s' is invalid", r.Link.CharData)
+	b, err := url.Parse(base)
+	if err != nil {
+		return fmt.Errorf("%s: %w: %v", msg, ErrInvalidURI, err)
+	}
+	l, err := url.Parse(string(r.Link.CharData))
+	if err != nil {
+		return fmt.Errorf("%s: %w: %v", msg, ErrInvalidURI, err)
+	}
+	u := b.ResolveReference(l)
+	if !u.IsAbs() {
+		msg := fmt.Sprintf("Element <guid> value '%s' is invalid", u)
+		return fmt.Errorf("%s: %w: must be an absolute URI", msg, ErrInvalidURI)
+	}
+	if ok, err := IsValidURI(u.String()); !ok {
+		msg := fmt.Sprintf("Element <guid> value '%s' is invalid", u)
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+	isPermaLink := IsPermaLink("true")
+	r.GUID = &GUID{
+		XMLName:     xml.Name{Space: "", Local: "guid"},
+		CharData:    []byte(u.String()),
+		IsPermaLink: &isPermaLink,
+	}
+	return nil
+}
+
+// Returns the time at which <item> was published and whether it is known.
+//
+// The time is that of the <pubDate> of <item> or, if it has no <pubDate>, its
+// <dc:date>. An invalid date is not known.
+func (r *Item) PublishedTime() (time.Time, bool) {
+	if r.PubDate != nil {
+		t, err := ParseDate(string(r.PubDate.CharData))
+		return t, err == nil
+	}
+	if r.DCDate != nil {
+		t, err := r.DCDate.Time()
+		return t, err == nil
+	}
+	return time.Time{}, false
+}
+
+// Returns the permalink of <item> (i.e. the URL of its canonical page) and
+// whether one was found.
+//
+// The permalink is the <guid>, if it is a permalink (i.e. 'isPermaLink' is
+// "true" or absent) and a valid URI, otherwise the <link>.
+func (r *Item) Permalink() (string, bool) {
+	if r.GUID != nil && (r.GUID.IsPermaLink == nil || *r.GUID.IsPermaLink == "true") {
+		if guid := string(r.GUID.CharData); guid != "" {
+			if ok, _ := IsValidURI(guid); ok {
+				return guid, true
+			}
+		}
+	}
+	if r.Link != nil && len(r.Link.CharData) > 0 {
+		return string(r.Link.CharData), true
+	}
+	return "", false
+}
+
+// Removes <category>s of <item> with the same value and 'domain' attribute,
+// keeping the first occurrence. The order of the remaining categories is
+// preserved.
+//
+// A <category> without a 'domain' is distinct from one with an empty
+// 'domain'.
+func (r *Item) DedupeCategories() {
+	type key struct {
+		value, domain string
+		hasDomain     bool
+	}
+	seen := map[key]bool{}
+	deduped := []*Category{}
+	for _, category := range r.Category {
+		if category == nil {
+			continue
+		}
+		k := key{value: string(category.CharData)}
+		if category.Domain != nil {
+			k.domain, k.hasDomain = *category.Domain, true
+		}
+		if !seen[k] {
+			seen[k] = true
+			deduped = append(deduped, category)
+		}
+	}
+	r.Category = deduped
+}
+
+// Returns a hash of the content of <item>, which is its <title>, <link>,
+// <description>, and <enclosure> URL.
+//
+// The <guid> and <pubDate> of <item> are not part of its content, so the same
+// article published by different sources (with different guids) has the same
+// hash.
+func (r *Item) Hash() string {
+	var title, link, description, enclosure string
+	if r.Title != nil {
+		title = string(r.Title.CharData)
+	}
+	if r.Link != nil {
+		link = string(r.Link.CharData)
+	}
+	if r.Description != nil {
+		description = string(r.Description.CharData)
+	}
+	if r.Enclosure != nil && r.Enclosure.URL != nil {
+		enclosure = *r.Enclosure.URL
+	}
+	h := sha256.New()
+	for _, s := range []string{title, link, description, enclosure} {
+		// Separate each value, so that moving content between elements changes
+		// the hash.
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Removes items with the same content (see Item.Hash) from 'items', keeping
+// the first occurrence. The order of the remaining items is preserved.
+func DedupeItems(items []*Item) []*Item {
+	seen := map[string]bool{}
+	deduped := []*Item{}
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		if h := item.Hash(); !seen[h] {
+			seen[h] = true
+			deduped = append(deduped, item)
+		}
+	}
+	return deduped
+}
+
+// Returns the 'src' of the first <img> in the HTML <description> of <item>
+// and whether one was found.
+//
+// A relative 'src' is resolved against the <link> of <item> or, if it is not
+// an absolute URI, the <link> of <channel> 'c' (which may be nil).
+//
+// NOTE: The description is tokenized as HTML (golang.org/x/net/html), so
+// malformed markup (e.g. unquoted attribute values or a stray '<') is handled
+// as a browser would. Content extension elements (e.g. <content:encoded>) are
+// not currently supported by this package and are therefore not consulted.
+func (r *Item) FirstInlineImage(c *Channel) (string, bool) {
+	if r.Description == nil {
+		return "", false
+	}
+	z := html.NewTokenizer(bytes.NewReader(r.Description.CharData))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return "", false
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := z.Token()
+			if t.Data != "img" {
+				continue
+			}
+			for _, a := range t.Attr {
+				if a.Key != "src" || a.Val == "" {
+					continue
+				}
+				return resolveLink(a.Val, r.Link, c), true
+			}
+		}
+	}
+}
+
+// Returns 's' resolved against the first of 'link' and the <link> of <channel>
+// 'c' that is an absolute URI, otherwise 's' unchanged.
+func resolveLink(s string, link *Link, c *Channel) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+	bases := []string{}
+	if link != nil {
+		bases = append(bases, string(link.CharData))
+	}
+	if c != nil {
+		bases = append(bases, string(c.Link.CharData))
+	}
+	for _, b := range bases {
+		if base, err := url.Parse(b); err == nil && base.IsAbs() {
+			return base.ResolveReference(u).String()
+		}
+	}
+	return s
+}
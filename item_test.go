@@ -0,0 +1,396 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemSetEnclosure(t *testing.T) {
+	t.Run("test set enclosure - ok - inferred type", func(t *testing.T) {
+		var r Item
+		err := r.SetEnclosure("https://example.com/audio.mp3", 1337, "")
+		assert.Nil(t, err)
+		assert.Equal(t, &Enclosure{
+			XMLName: xml.Name{Space: "", Local: "enclosure"},
+			URL:     Ptr("https://example.com/audio.mp3"),
+			Length:  Ptr("1337"),
+			Type:    Ptr("audio/mpeg"),
+		}, r.Enclosure)
+		ret, errs := r.Enclosure.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test set enclosure - ok - explicit type", func(t *testing.T) {
+		var r Item
+		err := r.SetEnclosure("https://example.com/audio", 0, "audio/ogg")
+		assert.Nil(t, err)
+		assert.Equal(t, "audio/ogg", *r.Enclosure.Type)
+	})
+	t.Run("test set enclosure - fail - invalid uri", func(t *testing.T) {
+		var r Item
+		err := r.SetEnclosure("bad uri", 1337, "")
+		assert.ErrorIs(t, err, ErrInvalidURI)
+		assert.Nil(t, r.Enclosure)
+	})
+	t.Run("test set enclosure - fail - negative length", func(t *testing.T) {
+		var r Item
+		err := r.SetEnclosure("https://example.com/audio.mp3", -1, "")
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Nil(t, r.Enclosure)
+	})
+	t.Run("test set enclosure - fail - unknown type", func(t *testing.T) {
+		var r Item
+		err := r.SetEnclosure("https://example.com/audio", 1337, "")
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Nil(t, r.Enclosure)
+	})
+}
+
+func TestItemAuthorDisplay(t *testing.T) {
+	c := &Channel{ManagingEditor: ManagingEditor("editor@example.com (Editor)")}
+	t.Run("test author display - author name", func(t *testing.T) {
+		r := Item{Author: []*Author{{CharData: []byte("first.last@example.com (First Last)")}}}
+		assert.Equal(t, "First Last", r.AuthorDisplay(c))
+	})
+	t.Run("test author display - author address", func(t *testing.T) {
+		r := Item{Author: []*Author{{CharData: []byte("first.last@example.com")}}}
+		assert.Equal(t, "first.last@example.com", r.AuthorDisplay(c))
+	})
+	t.Run("test author display - author before dc:creator", func(t *testing.T) {
+		r := Item{
+			Author:       []*Author{{CharData: []byte("first.last@example.com (First Last)")}},
+			DCCreator:    &DCCreator{CharData: []byte("Creator")},
+			ITunesAuthor: &ITunesAuthor{CharData: []byte("iTunes Author")},
+		}
+		assert.Equal(t, "First Last", r.AuthorDisplay(c))
+	})
+	t.Run("test author display - missing author - dc:creator", func(t *testing.T) {
+		r := Item{
+			DCCreator:    &DCCreator{CharData: []byte(" Creator ")},
+			ITunesAuthor: &ITunesAuthor{CharData: []byte("iTunes Author")},
+		}
+		assert.Equal(t, "Creator", r.AuthorDisplay(c))
+	})
+	t.Run("test author display - empty dc:creator - itunes:author", func(t *testing.T) {
+		r := Item{
+			DCCreator:    &DCCreator{CharData: []byte("")},
+			ITunesAuthor: &ITunesAuthor{CharData: []byte("iTunes Author")},
+		}
+		assert.Equal(t, "iTunes Author", r.AuthorDisplay(c))
+	})
+	t.Run("test author display - empty itunes:author - managing editor", func(t *testing.T) {
+		r := Item{ITunesAuthor: &ITunesAuthor{CharData: []byte("")}}
+		assert.Equal(t, "Editor", r.AuthorDisplay(c))
+	})
+	t.Run("test author display - parsed", func(t *testing.T) {
+		data := []byte(`<item xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">` +
+			`<title>Title</title><itunes:author>iTunes Author</itunes:author><dc:creator>Creator</dc:creator></item>`)
+		var r Item
+		err := xml.Unmarshal(data, &r)
+		assert.Nil(t, err)
+		assert.Empty(t, r.Author)
+		assert.Equal(t, "Creator", r.AuthorDisplay(c))
+		r.DCCreator = nil
+		assert.Equal(t, "iTunes Author", r.AuthorDisplay(c))
+	})
+	t.Run("test author display - empty author - managing editor", func(t *testing.T) {
+		r := Item{Author: []*Author{{CharData: []byte("")}}}
+		assert.Equal(t, "Editor", r.AuthorDisplay(c))
+	})
+	t.Run("test author display - missing author - managing editor", func(t *testing.T) {
+		r := Item{}
+		assert.Equal(t, "Editor", r.AuthorDisplay(c))
+	})
+	t.Run("test author display - none", func(t *testing.T) {
+		r := Item{}
+		assert.Equal(t, "", r.AuthorDisplay(&Channel{}))
+		assert.Equal(t, "", r.AuthorDisplay(nil))
+	})
+}
+
+func TestItemSetPermalinkGUID(t *testing.T) {
+	t.Run("test set permalink guid - ok - relative link", func(t *testing.T) {
+		r := Item{Link: &Link{CharData: []byte("/post/1")}}
+		err := r.SetPermalinkGUID("https://example.com")
+		assert.Nil(t, err)
+		assert.Equal(t, &GUID{
+			XMLName:     xml.Name{Space: "", Local: "guid"},
+			CharData:    []byte("https://example.com/post/1"),
+			IsPermaLink: Ptr(IsPermaLink("true")),
+		}, r.GUID)
+		ret, errs := r.GUID.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test set permalink guid - ok - absolute link", func(t *testing.T) {
+		r := Item{Link: &Link{CharData: []byte("https://example.org/post/1")}}
+		err := r.SetPermalinkGUID("https://example.com")
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.org/post/1", string(r.GUID.CharData))
+	})
+	t.Run("test set permalink guid - fail - missing link", func(t *testing.T) {
+		var r Item
+		err := r.SetPermalinkGUID("https://example.com")
+		assert.ErrorIs(t, err, ErrInvalidElement)
+		assert.Nil(t, r.GUID)
+	})
+	t.Run("test set permalink guid - fail - relative base", func(t *testing.T) {
+		r := Item{Link: &Link{CharData: []byte("/post/1")}}
+		err := r.SetPermalinkGUID("example.com")
+		assert.ErrorIs(t, err, ErrInvalidURI)
+		assert.Nil(t, r.GUID)
+	})
+}
+
+func TestItemHash(t *testing.T) {
+	t.Run("test hash - same content", func(t *testing.T) {
+		a := Item{
+			Title: &Title{CharData: []byte("Title")},
+			GUID:  &GUID{CharData: []byte("https://a.example.com/1")},
+		}
+		b := Item{
+			Title: &Title{CharData: []byte("Title")},
+			GUID:  &GUID{CharData: []byte("https://b.example.com/1")},
+		}
+		assert.Equal(t, a.Hash(), b.Hash())
+	})
+	t.Run("test hash - different content", func(t *testing.T) {
+		a := Item{Title: &Title{CharData: []byte("Title")}}
+		b := Item{Description: &Description{CharData: []byte("Title")}}
+		assert.NotEqual(t, a.Hash(), b.Hash())
+	})
+}
+
+func TestDedupeItems(t *testing.T) {
+	t.Run("test dedupe items", func(t *testing.T) {
+		a := &Item{
+			Title: &Title{CharData: []byte("Title")},
+			Link:  &Link{CharData: []byte("https://example.com/1")},
+			GUID:  &GUID{CharData: []byte("1"), IsPermaLink: Ptr(IsPermaLink("false"))},
+		}
+		b := &Item{
+			Title: &Title{CharData: []byte("Other")},
+			Link:  &Link{CharData: []byte("https://example.com/2")},
+		}
+		c := &Item{
+			Title: &Title{CharData: []byte("Title")},
+			Link:  &Link{CharData: []byte("https://example.com/1")},
+			GUID:  &GUID{CharData: []byte("2"), IsPermaLink: Ptr(IsPermaLink("false"))},
+		}
+		assert.Equal(t, []*Item{a, b}, DedupeItems([]*Item{a, b, c}))
+	})
+}
+
+func TestItemFirstInlineImage(t *testing.T) {
+	t.Run("test first inline image - ok", func(t *testing.T) {
+		r := Item{Description: &Description{CharData: []byte(`<p>Lead<br><IMG alt="a" SRC="https://example.com/a.png"></p><img src="https://example.com/b.png">`)}}
+		src, ok := r.FirstInlineImage(nil)
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/a.png", src)
+	})
+	t.Run("test first inline image - ok - relative src", func(t *testing.T) {
+		r := Item{
+			Link:        &Link{CharData: []byte("https://example.com/post/1")},
+			Description: &Description{CharData: []byte(`<img src="/a.png">&nbsp;<img src="/b.png">`)},
+		}
+		src, ok := r.FirstInlineImage(nil)
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/a.png", src)
+	})
+	t.Run("test first inline image - ok - channel link", func(t *testing.T) {
+		c := &Channel{Link: Link{CharData: []byte("https://example.com/blog/")}}
+		r := Item{Description: &Description{CharData: []byte(`<img src="a.png">`)}}
+		src, ok := r.FirstInlineImage(c)
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/blog/a.png", src)
+		r.Link = &Link{CharData: []byte("/post/1")}
+		src, ok = r.FirstInlineImage(c)
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/blog/a.png", src)
+		src, ok = r.FirstInlineImage(nil)
+		assert.True(t, ok)
+		assert.Equal(t, "a.png", src)
+	})
+	t.Run("test first inline image - ok - malformed", func(t *testing.T) {
+		for _, html := range []string{
+			`<p class=lead>1 < 2 &amp; 3 &nbsp;&copy;</p><img src=https://example.com/a.png><img src="https://example.com/b.png">`,
+			`<p>a <<b>> c & d</p><img src="https://example.com/a.png">`,
+			`<a href="x" "y">z</a><img src="https://example.com/a.png">`,
+		} {
+			r := Item{Description: &Description{CharData: []byte(html)}}
+			src, ok := r.FirstInlineImage(nil)
+			assert.True(t, ok, html)
+			assert.Equal(t, "https://example.com/a.png", src, html)
+		}
+	})
+	t.Run("test first inline image - none", func(t *testing.T) {
+		r := Item{Description: &Description{CharData: []byte(`<p>No images</p>`)}}
+		src, ok := r.FirstInlineImage(nil)
+		assert.False(t, ok)
+		assert.Equal(t, "", src)
+		src, ok = (&Item{}).FirstInlineImage(nil)
+		assert.False(t, ok)
+		assert.Equal(t, "", src)
+	})
+	t.Run("test first inline image - deeply nested", func(t *testing.T) {
+		// The description is tokenized iteratively, so pathological nesting
+		// does not exhaust the stack.
+		html := strings.Repeat("<div>", 100000) + `<img src="https://example.com/a.png">` + strings.Repeat("</div>", 100000)
+		r := Item{Description: &Description{CharData: []byte(html)}}
+		src, ok := r.FirstInlineImage(nil)
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/a.png", src)
+		r = Item{Description: &Description{CharData: []byte(strings.Repeat("<div>", 100000))}}
+		src, ok = r.FirstInlineImage(nil)
+		assert.False(t, ok)
+		assert.Equal(t, "", src)
+	})
+}
+
+func TestItemAuthorEmails(t *testing.T) {
+	t.Run("test author emails - round-trip", func(t *testing.T) {
+		data := []byte(`<item><title>Title</title><author>first.last@example.com (First Last)</author><author>other@example.com</author></item>`)
+		var r Item
+		err := xml.Unmarshal(data, &r)
+		assert.Nil(t, err)
+		assert.Len(t, r.Author, 2)
+		assert.Equal(t, []string{"first.last@example.com", "other@example.com"}, r.AuthorEmails())
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		s, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		assert.Equal(t, data, s)
+	})
+	t.Run("test author emails - fail - invalid author", func(t *testing.T) {
+		r := Item{
+			XMLName: xml.Name{Space: "", Local: "item"},
+			Title:   &Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+			Author: []*Author{
+				{XMLName: xml.Name{Space: "", Local: "author"}, CharData: []byte("first.last@example.com")},
+				{XMLName: xml.Name{Space: "", Local: "author"}, CharData: []byte("First Last")},
+			},
+		}
+		assert.Equal(t, []string{"first.last@example.com"}, r.AuthorEmails())
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidMailAddress)
+	})
+}
+
+func TestItemDedupeCategories(t *testing.T) {
+	t.Run("test dedupe categories", func(t *testing.T) {
+		a := &Category{CharData: []byte("News"), Domain: Ptr("https://example.com/categories")}
+		b := &Category{CharData: []byte("News")}
+		c := &Category{CharData: []byte("News"), Domain: Ptr("https://example.com/categories")}
+		r := Item{Category: []*Category{a, b, c}}
+		r.DedupeCategories()
+		assert.Equal(t, []*Category{a, b}, r.Category)
+	})
+}
+
+func TestItemPermalink(t *testing.T) {
+	cases := []struct {
+		name string
+		r    Item
+		want string
+		ok   bool
+	}{
+		{
+			"permalink guid",
+			Item{
+				Link: &Link{CharData: []byte("https://example.com/post/1?ref=rss")},
+				GUID: &GUID{CharData: []byte("https://example.com/post/1")},
+			},
+			"https://example.com/post/1",
+			true,
+		},
+		{
+			"explicit permalink guid",
+			Item{GUID: &GUID{CharData: []byte("https://example.com/post/1"), IsPermaLink: Ptr(IsPermaLink("true"))}},
+			"https://example.com/post/1",
+			true,
+		},
+		{
+			"opaque guid with link",
+			Item{
+				Link: &Link{CharData: []byte("https://example.com/post/1")},
+				GUID: &GUID{CharData: []byte("https://example.com/post/1?id=1"), IsPermaLink: Ptr(IsPermaLink("false"))},
+			},
+			"https://example.com/post/1",
+			true,
+		},
+		{
+			"invalid guid with link",
+			Item{
+				Link: &Link{CharData: []byte("https://example.com/post/1")},
+				GUID: &GUID{CharData: []byte("1337")},
+			},
+			"https://example.com/post/1",
+			true,
+		},
+		{
+			"neither",
+			Item{GUID: &GUID{CharData: []byte("1337"), IsPermaLink: Ptr(IsPermaLink("false"))}},
+			"",
+			false,
+		},
+		{
+			"empty",
+			Item{},
+			"",
+			false,
+		},
+	}
+	for _, tc := range cases {
+		t.Run("test permalink - "+tc.name, func(t *testing.T) {
+			got, ok := tc.r.Permalink()
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestItemPublishedTime(t *testing.T) {
+	want := time.Date(2003, time.June, 3, 9, 39, 21, 0, time.UTC)
+	t.Run("test published time - pubDate", func(t *testing.T) {
+		r := Item{
+			PubDate: &PubDate{CharData: []byte("Tue, 03 Jun 2003 09:39:21 GMT")},
+			DCDate:  &DCDate{CharData: []byte("2001-01-01")},
+		}
+		tm, ok := r.PublishedTime()
+		assert.True(t, ok)
+		assert.True(t, want.Equal(tm))
+	})
+	t.Run("test published time - dc:date", func(t *testing.T) {
+		var r Item
+		err := xml.Unmarshal([]byte(`<item xmlns:dc="http://purl.org/dc/elements/1.1/"><dc:date>2003-06-03T09:39:21Z</dc:date></item>`), &r)
+		assert.Nil(t, err)
+		assert.Nil(t, r.PubDate)
+		tm, ok := r.PublishedTime()
+		assert.True(t, ok)
+		assert.True(t, want.Equal(tm))
+	})
+	t.Run("test published time - invalid", func(t *testing.T) {
+		r := Item{PubDate: &PubDate{CharData: []byte("not a date")}}
+		_, ok := r.PublishedTime()
+		assert.False(t, ok)
+		r = Item{DCDate: &DCDate{CharData: []byte("not a date")}}
+		_, ok = r.PublishedTime()
+		assert.False(t, ok)
+	})
+	t.Run("test published time - none", func(t *testing.T) {
+		tm, ok := (&Item{}).PublishedTime()
+		assert.False(t, ok)
+		assert.True(t, tm.IsZero())
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// iTunes (Apple Podcasts) elements used within RSS documents.
+package rss
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// The namespace of iTunes elements (e.g. <itunes:author>).
+//
+// See: https://podcasters.apple.com/support/823-podcast-requirements
+const ITUNESNAMESPACE = "http://www.itunes.com/dtds/podcast-1.0.dtd"
+
+// <itunes:author> is an optional sub-element of <item>. It contains the name
+// of the author of the episode (e.g. the host or group that created it).
+//
+// Example:
+//
+//	<itunes:author>Jane Doe</itunes:author>
+//
+// See: https://help.apple.com/itc/podcasts_connect/#/itcb54353390
+type ITunesAuthor struct {
+	XMLName  xml.Name `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd author"` // required
+	CharData []byte   `xml:",chardata"`                                         // required
+}
+
+// Returns whether <itunes:author> is valid and a slice containing any errors.
+func (r ITunesAuthor) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
+	if ok, err := IsNotEmpty(string(r.CharData)); !ok {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+	}
+	return isValid, errs
+}
+
+// <itunes:owner> is an optional sub-element of <channel>. It contains the
+// contact information of the owner of the podcast, which is used by Apple for
+// administrative communication and is not displayed.
+//
+// Example:
+//
+//	<itunes:owner>
+//	  <itunes:name>Jane Doe</itunes:name>
+//	  <itunes:email>jane.doe@example.com</itunes:email>
+//	</itunes:owner>
+//
+// See: https://help.apple.com/itc/podcasts_connect/#/itcb54353390
+type ITunesOwner struct {
+	XMLName xml.Name     `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd owner"`           // required
+	Name    *ITunesName  `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd name,omitempty"`  // optional
+	Email   *ITunesEmail `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd email,omitempty"` // optional
+}
+
+// Returns whether <itunes:owner> is valid and a slice containing any errors.
+func (r ITunesOwner) IsValid() (bool, []error) {
+	return Validate(r)
+}
+
+// <itunes:name> is an optional sub-element of <itunes:owner>.
+type ITunesName struct {
+	XMLName  xml.Name `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd name"` // required
+	CharData []byte   `xml:",chardata"`                                       // required
+}
+
+// Returns whether <itunes:name> is valid and a slice containing any errors.
+func (r ITunesName) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
+	if ok, err := IsNotEmpty(string(r.CharData)); !ok {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+	}
+	return isValid, errs
+}
+
+// <itunes:email> is an optional sub-element of <itunes:owner>. It contains
+// the mail address of the owner (e.g. "jane.doe@example.com").
+type ITunesEmail struct {
+	XMLName  xml.Name `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd email"` // required
+	CharData []byte   `xml:",chardata"`                                        // required
+}
+
+// Returns whether <itunes:email> is valid and a slice containing any errors.
+func (r ITunesEmail) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
+	if ok, err := IsNotEmpty(string(r.CharData)); !ok {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+	} else if ok, err := IsValidMailAddress(string(r.CharData)); !ok {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+	}
+	return isValid, errs
+}
+
+// <itunes:explicit> is an optional sub-element of <channel> and <item>. It
+// indicates whether the podcast or episode contains explicit content.
+//
+// Example:
+//
+//	<itunes:explicit>false</itunes:explicit>
+//
+// See: https://help.apple.com/itc/podcasts_connect/#/itcb54353390
+type ITunesExplicit struct {
+	XMLName  xml.Name `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd explicit"` // required
+	CharData []byte   `xml:",chardata"`                                           // required
+}
+
+// Returns whether <itunes:explicit> is valid and a slice containing any
+// errors.
+//
+// <itunes:explicit> must be "true" or "false". The legacy values "yes",
+// "no", and "clean" are also accepted.
+func (r ITunesExplicit) IsValid() (bool, []error) {
+	return r.validate(validateOptions{})
+}
+
+func (r ITunesExplicit) validate(o validateOptions) (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
+	if _, err := r.parse(o.lenientBooleans); err != nil {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+	}
+	return isValid, errs
+}
+
+// Returns whether <itunes:explicit> indicates explicit content, accepting the
+// variants accepted by WithLenientBooleans (e.g. "Yes" is true). "clean" is
+// false.
+func (r ITunesExplicit) Bool() (bool, error) {
+	return r.parse(true)
+}
+
+// Parses the value of <itunes:explicit> (see parseBool).
+func (r ITunesExplicit) parse(lenient bool) (bool, error) {
+	s := string(r.CharData)
+	if lenient {
+		s = strings.ToLower(strings.TrimSpace(s))
+	}
+	switch s {
+	case "yes":
+		return true, nil
+	case "no", "clean":
+		return false, nil
+	}
+	if b, err := parseBool(s, lenient); err == nil {
+		return b, nil
+	}
+	return false, fmt.Errorf("%w: must be \"true\", \"false\", \"yes\", \"no\", or \"clean\"", ErrInvalidValue)
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestITunesAuthor(t *testing.T) {
+	t.Run("test <itunes:author> - ok - round-trip", func(t *testing.T) {
+		data := []byte(`<item xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd"><title>Title</title><itunes:author>NASA</itunes:author></item>`)
+		var r Item
+		err := xml.Unmarshal(data, &r)
+		assert.Nil(t, err)
+		assert.Equal(t, "NASA", string(r.ITunesAuthor.CharData))
+		assert.Empty(t, r.Author)
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		s, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		assert.Equal(t, `<item><title>Title</title><author xmlns="http://www.itunes.com/dtds/podcast-1.0.dtd">NASA</author></item>`, string(s))
+	})
+	t.Run("test <itunes:author> - fail - empty", func(t *testing.T) {
+		r := ITunesAuthor{XMLName: xml.Name{Space: ITUNESNAMESPACE, Local: "author"}}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrEmptyValue)
+	})
+}
+
+func TestITunesOwner(t *testing.T) {
+	t.Run("test <itunes:owner> - ok", func(t *testing.T) {
+		data := []byte(`<itunes:owner xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">` +
+			`<itunes:name>NASA</itunes:name><itunes:email>editor@example.com</itunes:email></itunes:owner>`)
+		ret, errs := ValidateElementXML("itunes:owner", data)
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		var r ITunesOwner
+		err := xml.Unmarshal(data, &r)
+		assert.Nil(t, err)
+		assert.Equal(t, "NASA", string(r.Name.CharData))
+		assert.Equal(t, "editor@example.com", string(r.Email.CharData))
+	})
+	t.Run("test <itunes:owner> - fail - invalid email", func(t *testing.T) {
+		data := []byte(`<itunes:owner xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">` +
+			`<itunes:email>not an address</itunes:email></itunes:owner>`)
+		ret, errs := ValidateElementXML("itunes:owner", data)
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidMailAddress)
+	})
+}
+
+func TestITunesExplicit(t *testing.T) {
+	for _, s := range []string{"true", "false", "yes", "no", "clean"} {
+		t.Run("test <itunes:explicit> - ok - "+s, func(t *testing.T) {
+			r := ITunesExplicit{XMLName: xml.Name{Space: ITUNESNAMESPACE, Local: "explicit"}, CharData: []byte(s)}
+			ret, errs := r.IsValid()
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+		})
+	}
+	t.Run("test <itunes:explicit> - fail - yes (uppercase)", func(t *testing.T) {
+		r := ITunesExplicit{XMLName: xml.Name{Space: ITUNESNAMESPACE, Local: "explicit"}, CharData: []byte("Yes")}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		b, err := r.Bool()
+		assert.Nil(t, err)
+		assert.True(t, b)
+	})
+	t.Run("test <itunes:explicit> - bool - clean", func(t *testing.T) {
+		r := ITunesExplicit{CharData: []byte("clean")}
+		b, err := r.Bool()
+		assert.Nil(t, err)
+		assert.False(t, b)
+	})
+}
@@ -0,0 +1,409 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Parsing functions for the rss package.
+package rss
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// A ParseOption configures how an RSS document is parsed.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	comments        bool
+	caseInsensitive bool
+	maxItems        int
+}
+
+// Retains XML comments that are direct children of <rss> and <channel> (e.g.
+// <!-- generated by ... -->) in the XMLComment field of RSS and Channel, so
+// that they are emitted again when marshaling.
+//
+// By default, comments are discarded.
+//
+// NOTE: Multiple comments within the same element are concatenated and
+// emitted as a single comment preceding the first sub-element.
+func WithComments() ParseOption {
+	return func(o *parseOptions) { o.comments = true }
+}
+
+// Matches the names of RSS elements case-insensitively (e.g. <pubdate> or
+// <PubDate> is parsed as <pubDate>).
+//
+// By default, element names are case-sensitive, as required by XML.
+func WithCaseInsensitiveElements() ParseOption {
+	return func(o *parseOptions) { o.caseInsensitive = true }
+}
+
+// Parses at most 'n' <item>s of <channel>; subsequent <item>s are skipped
+// without being decoded. All other elements are parsed as usual, so the
+// metadata of <channel> is complete.
+//
+// If <item>s were skipped, Parse returns the truncated document together with
+// an error wrapping ErrItemLimitReached. If 'n' is zero or negative, the
+// number of <item>s is not limited.
+func WithMaxItems(n int) ParseOption {
+	return func(o *parseOptions) { o.maxItems = n }
+}
+
+// Parses an RSS document read from 'r'.
+//
+// RSS elements are matched whether they are in no namespace or in the RSS 2.0
+// namespace, so documents that declare xmlns="http://backend.userland.com/rss2"
+// on <rss> are parsed as if they did not. The same holds for any other default
+// namespace declared on <rss>. Sub-elements of <channel> and <item> in any
+// other namespace are extension elements: those modeled by this package (e.g.
+// <atom:link>, <dc:date>, <itunes:author>) are decoded into their types (e.g.
+// AtomLink, DCDate, ITunesAuthor), and all others (e.g. <itunes:image>) are
+// skipped.
+//
+// Documents may be encoded in UTF-8, US-ASCII, or ISO-8859-1, as declared by
+// the XML declaration (e.g. <?xml version="1.0" encoding="ISO-8859-1"?>). The
+// character set of a document that is not UTF-8 is recorded in the Charset
+// field of RSS.
+func Parse(r io.Reader, opts ...ParseOption) (*RSS, error) {
+	o := parseOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	d := xml.NewDecoder(r)
+	charset := ""
+	d.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		name, cr, err := charsetReader(label, input)
+		charset = name
+		return cr, err
+	}
+	var tr xml.TokenReader = d
+	if o.caseInsensitive {
+		tr = &canonicalizer{r: tr, names: elementNames()}
+	}
+	var limiter *itemLimiter
+	if o.maxItems > 0 {
+		limiter = &itemLimiter{r: tr, max: o.maxItems}
+		tr = limiter
+	}
+	var rss RSS
+	if err := xml.NewTokenDecoder(tr).Decode(&rss); err != nil {
+		return nil, err
+	}
+	rss.Charset = charset
+	if !o.comments {
+		rss.XMLComment = ""
+		if rss.Channel != nil {
+			rss.Channel.XMLComment = ""
+		}
+	}
+	if limiter != nil && limiter.truncated {
+		return &rss, fmt.Errorf("%w: only the first %d <item>s were parsed", ErrItemLimitReached, o.maxItems)
+	}
+	return &rss, nil
+}
+
+// Parses the RSS document in the file at 'path'.
+//
+// If 'path' ends in ".gz", the file is decompressed (gzip) before parsing.
+func ParseFile(path string, opts ...ParseOption) (*RSS, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseNamed(path, f, opts...)
+}
+
+// Parses the RSS document in each ".xml" or ".xml.gz" file of the zip archive
+// read from 'r', which is 'size' bytes long. Other files are skipped.
+//
+// Returns the parsed documents keyed by file name and a slice containing an
+// error for each file that could not be parsed.
+func ParseArchive(r io.ReaderAt, size int64) (map[string]*RSS, []error) {
+	feeds, errs := map[string]*RSS{}, []error{}
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return feeds, append(errs, err)
+	}
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !(strings.HasSuffix(f.Name, ".xml") || strings.HasSuffix(f.Name, ".xml.gz")) {
+			continue
+		}
+		rss, err := parseArchiveFile(f)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", f.Name, err))
+			continue
+		}
+		feeds[f.Name] = rss
+	}
+	return feeds, errs
+}
+
+// Parses the RSS document in the file 'f' of a zip archive.
+func parseArchiveFile(f *zip.File) (*RSS, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return parseNamed(f.Name, rc)
+}
+
+// Parses the RSS document read from 'r', decompressing it (gzip) first if
+// 'name' ends in ".gz".
+func parseNamed(name string, r io.Reader, opts ...ParseOption) (*RSS, error) {
+	if strings.HasSuffix(name, ".gz") {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	return Parse(r, opts...)
+}
+
+// A canonicalizer is an xml.TokenReader that replaces the local name of each
+// element with its canonical name (e.g. "pubdate" with "pubDate").
+type canonicalizer struct {
+	r     xml.TokenReader
+	names map[string]string
+}
+
+func (c *canonicalizer) Token() (xml.Token, error) {
+	tok, err := c.r.Token()
+	switch t := tok.(type) {
+	case xml.StartElement:
+		if name, ok := c.names[strings.ToLower(t.Name.Local)]; ok {
+			t.Name.Local = name
+		}
+		return t, err
+	case xml.EndElement:
+		if name, ok := c.names[strings.ToLower(t.Name.Local)]; ok {
+			t.Name.Local = name
+		}
+		return t, err
+	}
+	return tok, err
+}
+
+// An itemLimiter is an xml.TokenReader that omits the <item>s of <channel>
+// after the first 'max'.
+type itemLimiter struct {
+	r         xml.TokenReader
+	max       int
+	n         int  // number of <item>s returned
+	depth     int  // depth of the current element (<rss> is 1)
+	truncated bool // whether any <item>s were omitted
+}
+
+func (l *itemLimiter) Token() (xml.Token, error) {
+	for {
+		tok, err := l.r.Token()
+		switch t := tok.(type) {
+		case xml.StartElement:
+			// <item> is a sub-element of <channel>, which is at depth 2.
+			if l.depth == 2 && t.Name.Local == "item" {
+				if l.n >= l.max {
+					l.truncated = true
+					if err := l.skip(); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				l.n++
+			}
+			l.depth++
+		case xml.EndElement:
+			l.depth--
+		}
+		return tok, err
+	}
+}
+
+// Reads the tokens of the current element, up to and including its end
+// element.
+func (l *itemLimiter) skip() error {
+	for depth := 1; depth > 0; {
+		tok, err := l.r.Token()
+		if err != nil {
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
+
+// Returns the names of all RSS elements, keyed by their lowercase name.
+func elementNames() map[string]string {
+	names := map[string]string{}
+	seen := map[reflect.Type]bool{}
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || seen[t] {
+			return
+		}
+		seen[t] = true
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			// Attributes are not elements.
+			if !f.IsExported() || contains(strings.Split(f.Tag.Get("xml"), ",")[1:], "attr") {
+				continue
+			}
+			if _, local := tagName(f); local != "" {
+				names[strings.ToLower(local)] = local
+			}
+			collect(f.Type)
+		}
+	}
+	collect(reflect.TypeOf(RSS{}))
+	return names
+}
+
+// Offset is the byte range of an element in the source document. Start is the
+// offset of the first byte of the start tag and End is the offset immediately
+// following the end tag.
+type Offset struct {
+	Start int64
+	End   int64
+}
+
+// Offsets maps an element path to its byte range in the source document.
+//
+// An element path is formed by joining the names of the element and its
+// ancestors with " > ". Elements of a known extension namespace are prefixed
+// with that namespace's prefix (e.g. "atom:link"). Elements that may be
+// repeated (e.g. <item>) are suffixed with their zero-based index among
+// siblings of the same name:
+//
+//	rss > channel > item[1] > title
+//	rss > channel > atom:link[0]
+type Offsets map[string]Offset
+
+// Elements that may appear more than once within their parent element.
+var repeatedElements = map[string]bool{
+	"item":      true,
+	"category":  true,
+	"hour":      true,
+	"day":       true,
+	"author":    true,
+	"atom:link": true,
+}
+
+// Parses an RSS document read from 'r' and records the byte range of each
+// element in the source document.
+//
+// This is useful for tooling (e.g. editor integrations) that maps validation
+// errors back to the source.
+func ParseWithOffsets(r io.Reader, opts ...ParseOption) (*RSS, Offsets, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	offsets, err := recordOffsets(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	rss, err := Parse(bytes.NewReader(data), opts...)
+	if rss == nil {
+		return nil, nil, err
+	}
+	return rss, offsets, err
+}
+
+// Tokenizes 'data' and records the byte range of each element.
+func recordOffsets(data []byte) (Offsets, error) {
+	type frame struct {
+		path  string
+		start int64
+		seen  map[string]int
+	}
+	offsets := Offsets{}
+	d := xml.NewDecoder(bytes.NewReader(data))
+	m := &offsetMapper{data: data}
+	d.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		charset, cr, err := charsetReader(label, input)
+		if charset == latin1 {
+			m.latin1, m.dec, m.src = true, d.InputOffset(), d.InputOffset()
+		}
+		return cr, err
+	}
+	stack := []*frame{{seen: map[string]int{}}}
+	for {
+		// InputOffset gives the location of the end of the most recently returned
+		// token and the beginning of the next token.
+		start := m.source(d.InputOffset())
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			parent := stack[len(stack)-1]
+			qname := t.Name.Local
+			if prefix, ok := namespacePrefixes[t.Name.Space]; ok {
+				qname = prefix + ":" + qname
+			}
+			name := qname
+			if repeatedElements[qname] {
+				name = fmt.Sprintf("%s[%d]", qname, parent.seen[qname])
+			}
+			parent.seen[qname]++
+			path := strings.TrimPrefix(parent.path+" > "+name, " > ")
+			stack = append(stack, &frame{path: path, start: start, seen: map[string]int{}})
+		case xml.EndElement:
+			f := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			offsets[f.path] = Offset{Start: f.start, End: m.source(d.InputOffset())}
+		}
+	}
+	return offsets, nil
+}
+
+// An offsetMapper maps offsets in the UTF-8 input of an xml.Decoder to
+// offsets in the source document 'data'.
+//
+// Once the XML declaration switches the decoder to ISO-8859-1, its offsets
+// count the bytes of the decoded UTF-8, in which each byte of the source
+// above 0x7F takes two bytes. The offsets passed to source must not decrease.
+type offsetMapper struct {
+	data   []byte
+	latin1 bool
+	dec    int64 // decoder offset corresponding to 'src'
+	src    int64 // source offset corresponding to 'dec'
+}
+
+// Returns the source offset corresponding to the decoder offset 'off'.
+func (m *offsetMapper) source(off int64) int64 {
+	if !m.latin1 || off <= m.dec {
+		return off - m.dec + m.src
+	}
+	for m.dec < off && m.src < int64(len(m.data)) {
+		if m.data[m.src] < 0x80 {
+			m.dec++
+		} else {
+			m.dec += 2
+		}
+		m.src++
+	}
+	return m.src
+}
@@ -0,0 +1,299 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFeed(t *testing.T) {
+	t.Run("test parse - sample", func(t *testing.T) {
+		f, err := os.Open("test/data/samples/sample-rss-2.xml")
+		assert.Nil(t, err)
+		defer f.Close()
+		r, err := Parse(f)
+		assert.Nil(t, err)
+		assert.Equal(t, "Liftoff News", string(r.Channel.Title.CharData))
+		assert.Equal(t, 4, len(r.Channel.Item))
+	})
+	t.Run("test parse - namespaced", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader([]byte(`<rss xmlns="http://www.w3.org/2005/Atom" version="2.0"></rss>`)))
+		assert.Nil(t, err)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], ErrInvalidNamespace)
+	})
+	t.Run("test parse - default namespace", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader([]byte(`<rss xmlns="http://backend.userland.com/rss2" version="2.0">` +
+			`<channel><title>Title</title><link>https://example.com</link><description>Description</description>` +
+			`<atom:link xmlns:atom="http://www.w3.org/2005/Atom" href="https://example.com/feed" rel="self"/>` +
+			`<item><title>Item</title></item></channel></rss>`)))
+		assert.Nil(t, err)
+		assert.Equal(t, "Title", string(r.Channel.Title.CharData))
+		assert.Equal(t, "https://example.com", string(r.Channel.Link.CharData))
+		assert.Equal(t, "Description", string(r.Channel.Description.CharData))
+		assert.Equal(t, 1, len(r.Channel.AtomLink))
+		assert.Equal(t, "https://example.com/feed", *r.Channel.AtomLink[0].Href)
+		assert.Equal(t, 1, len(r.Channel.Item))
+		assert.Equal(t, "Item", string(r.Channel.Item[0].Title.CharData))
+		r, err = Parse(bytes.NewReader([]byte(`<rss xmlns="http://backend.userland.com/rss2" version="2.0">`+
+			`<channel><TITLE>Title</TITLE></channel></rss>`)), WithCaseInsensitiveElements())
+		assert.Nil(t, err)
+		assert.Equal(t, "Title", string(r.Channel.Title.CharData))
+	})
+	t.Run("test parse - other default namespace", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader([]byte(`<rss xmlns="http://example.com/ns" version="2.0">` +
+			`<channel><title>Title</title><link>https://example.com</link><description>Description</description>` +
+			`<x:title xmlns:x="http://example.com/x">Other</x:title>` +
+			`<item><title>Item</title></item></channel></rss>`)))
+		assert.Nil(t, err)
+		assert.Equal(t, "Title", string(r.Channel.Title.CharData))
+		assert.Equal(t, "https://example.com", string(r.Channel.Link.CharData))
+		assert.Equal(t, "Description", string(r.Channel.Description.CharData))
+		assert.Equal(t, 1, len(r.Channel.Item))
+		assert.Equal(t, "Item", string(r.Channel.Item[0].Title.CharData))
+	})
+	t.Run("test parse - podcast", func(t *testing.T) {
+		f, err := os.Open("test/data/samples/sample-podcast.xml")
+		assert.Nil(t, err)
+		defer f.Close()
+		r, err := Parse(f)
+		assert.Nil(t, err)
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		// <itunes:image>, <itunes:category> and <itunes:author> are not decoded
+		// into <image>, <category> and <author>.
+		assert.Nil(t, r.Channel.Image)
+		assert.Empty(t, r.Channel.Category)
+		assert.Len(t, r.Channel.AtomLink, 1)
+		assert.Len(t, r.Channel.Item, 1)
+		assert.Equal(t, "Star City", string(r.Channel.Item[0].Title.CharData))
+		assert.Empty(t, r.Channel.Item[0].Author)
+		assert.Equal(t, "NASA", string(r.Channel.Item[0].ITunesAuthor.CharData))
+		b, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		assert.NotContains(t, string(b), "<image>")
+		assert.NotContains(t, string(b), "<category>")
+		assert.NotContains(t, string(b), "<author>")
+	})
+	t.Run("test parse - fail - malformed", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader([]byte(`<rss version="2.0"><channel>`)))
+		assert.Nil(t, r)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestParseFile(t *testing.T) {
+	path := "test/data/samples/sample-rss-2.xml"
+	want, err := ParseFile(path)
+	assert.Nil(t, err)
+	t.Run("test parse file - xml", func(t *testing.T) {
+		assert.Equal(t, "Liftoff News", want.Channel.TitleString())
+		assert.Len(t, want.Channel.Item, 4)
+	})
+	t.Run("test parse file - gzip", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		assert.Nil(t, err)
+		gzPath := filepath.Join(t.TempDir(), "sample-rss-2.xml.gz")
+		f, err := os.Create(gzPath)
+		assert.Nil(t, err)
+		gz := gzip.NewWriter(f)
+		_, err = gz.Write(data)
+		assert.Nil(t, err)
+		assert.Nil(t, gz.Close())
+		assert.Nil(t, f.Close())
+		r, err := ParseFile(gzPath)
+		assert.Nil(t, err)
+		assert.Equal(t, want, r)
+	})
+	t.Run("test parse file - fail - not found", func(t *testing.T) {
+		r, err := ParseFile("test/data/samples/missing.xml")
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+	t.Run("test parse file - fail - not gzip", func(t *testing.T) {
+		gzPath := filepath.Join(t.TempDir(), "sample-rss-2.xml.gz")
+		assert.Nil(t, os.WriteFile(gzPath, []byte("<rss></rss>"), 0o644))
+		r, err := ParseFile(gzPath)
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, gzip.ErrHeader)
+	})
+}
+
+func TestParseArchive(t *testing.T) {
+	newArchive := func(files map[string][]byte) *bytes.Reader {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for name, data := range files {
+			w, err := zw.Create(name)
+			assert.Nil(t, err)
+			_, err = w.Write(data)
+			assert.Nil(t, err)
+		}
+		assert.Nil(t, zw.Close())
+		return bytes.NewReader(buf.Bytes())
+	}
+	gzipped := func(data []byte) []byte {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		_, err := gz.Write(data)
+		assert.Nil(t, err)
+		assert.Nil(t, gz.Close())
+		return buf.Bytes()
+	}
+	t.Run("test parse archive", func(t *testing.T) {
+		r := newArchive(map[string][]byte{
+			"feeds/a.xml":    []byte(`<rss version="2.0"><channel><title>A</title></channel></rss>`),
+			"feeds/b.xml.gz": gzipped([]byte(`<rss version="2.0"><channel><title>B</title></channel></rss>`)),
+			"README.txt":     []byte("not a feed"),
+		})
+		feeds, errs := ParseArchive(r, r.Size())
+		assert.Empty(t, errs)
+		assert.Len(t, feeds, 2)
+		assert.Equal(t, "A", feeds["feeds/a.xml"].Channel.TitleString())
+		assert.Equal(t, "B", feeds["feeds/b.xml.gz"].Channel.TitleString())
+	})
+	t.Run("test parse archive - fail - malformed", func(t *testing.T) {
+		r := newArchive(map[string][]byte{
+			"a.xml": []byte(`<rss version="2.0"><channel><title>A</title></channel></rss>`),
+			"b.xml": []byte(`<rss version="2.0"><channel>`),
+		})
+		feeds, errs := ParseArchive(r, r.Size())
+		assert.Len(t, feeds, 1)
+		assert.Equal(t, "A", feeds["a.xml"].Channel.TitleString())
+		assert.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "b.xml: ")
+	})
+	t.Run("test parse archive - fail - not zip", func(t *testing.T) {
+		r := bytes.NewReader([]byte("<rss></rss>"))
+		feeds, errs := ParseArchive(r, r.Size())
+		assert.Empty(t, feeds)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], zip.ErrFormat)
+	})
+}
+
+func TestParseWithComments(t *testing.T) {
+	data := []byte(`<rss version="2.0"><!-- provenance --><channel><!-- generated by example --><title>Title</title></channel></rss>`)
+	t.Run("test parse with comments", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data), WithComments())
+		assert.Nil(t, err)
+		assert.Equal(t, " provenance ", r.XMLComment)
+		assert.Equal(t, " generated by example ", r.Channel.XMLComment)
+		s, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		assert.Contains(t, string(s), `<rss version="2.0"><!-- provenance --><channel>`)
+		assert.Contains(t, string(s), `<channel><!-- generated by example --><title>Title</title>`)
+	})
+	t.Run("test parse without comments", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data))
+		assert.Nil(t, err)
+		assert.Equal(t, "", r.XMLComment)
+		assert.Equal(t, "", r.Channel.XMLComment)
+		s, err := xml.Marshal(r)
+		assert.Nil(t, err)
+		assert.NotContains(t, string(s), "<!--")
+	})
+}
+
+func TestParseWithCaseInsensitiveElements(t *testing.T) {
+	data := []byte(`<rss version="2.0"><Channel><title>Title</title><item><title>Item</title><pubdate>Tue, 10 Jun 2003 04:00:00 GMT</pubdate></item></Channel></rss>`)
+	t.Run("test parse with case-insensitive elements", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data), WithCaseInsensitiveElements())
+		assert.Nil(t, err)
+		assert.NotNil(t, r.Channel)
+		assert.Equal(t, "pubDate", r.Channel.Item[0].PubDate.XMLName.Local)
+		assert.Equal(t, "Tue, 10 Jun 2003 04:00:00 GMT", string(r.Channel.Item[0].PubDate.CharData))
+	})
+	t.Run("test parse without case-insensitive elements", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data))
+		assert.Nil(t, err)
+		assert.Nil(t, r.Channel)
+	})
+}
+
+func TestParseWithMaxItems(t *testing.T) {
+	var b strings.Builder
+	b.WriteString(`<rss version="2.0"><channel><title>Title</title><link>https://example.com</link>`)
+	for i := 0; i < 100; i++ {
+		fmt.Fprintf(&b, `<item><title>Item %d</title><category><nested>%d</nested></category></item>`, i, i)
+	}
+	// Metadata after the <item>s is still parsed.
+	b.WriteString(`<description>Description</description><ttl>60</ttl></channel></rss>`)
+	data := []byte(b.String())
+	t.Run("test parse with max items", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data), WithMaxItems(10))
+		assert.ErrorIs(t, err, ErrItemLimitReached)
+		assert.NotNil(t, r)
+		assert.Len(t, r.Channel.Item, 10)
+		assert.Equal(t, "Item 0", string(r.Channel.Item[0].Title.CharData))
+		assert.Equal(t, "Item 9", string(r.Channel.Item[9].Title.CharData))
+		assert.Equal(t, "Title", r.Channel.TitleString())
+		assert.Equal(t, "Description", r.Channel.DescriptionString())
+		assert.Equal(t, "60", string(r.Channel.TTL.CharData))
+	})
+	t.Run("test parse with max items - under limit", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data), WithMaxItems(100))
+		assert.Nil(t, err)
+		assert.Len(t, r.Channel.Item, 100)
+	})
+	t.Run("test parse with max items - case-insensitive", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(bytes.ReplaceAll(data, []byte("item>"), []byte("ITEM>"))),
+			WithMaxItems(10), WithCaseInsensitiveElements())
+		assert.ErrorIs(t, err, ErrItemLimitReached)
+		assert.Len(t, r.Channel.Item, 10)
+	})
+	t.Run("test parse without max items", func(t *testing.T) {
+		r, err := Parse(bytes.NewReader(data), WithMaxItems(0))
+		assert.Nil(t, err)
+		assert.Len(t, r.Channel.Item, 100)
+	})
+}
+
+func TestParseWithOffsets(t *testing.T) {
+	t.Run("test parse with offsets - sample", func(t *testing.T) {
+		data, err := os.ReadFile("test/data/samples/sample-rss-2.xml")
+		assert.Nil(t, err)
+		r, offsets, err := ParseWithOffsets(bytes.NewReader(data))
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(r.Channel.Item))
+		// The second <item> begins after the end of the first <item>.
+		first := bytes.Index(data, []byte("</item>")) + len("</item>")
+		start := first + bytes.Index(data[first:], []byte("<item>"))
+		end := start + bytes.Index(data[start:], []byte("</item>")) + len("</item>")
+		assert.Equal(t, Offset{Start: int64(start), End: int64(end)}, offsets["rss > channel > item[1]"])
+		o := offsets["rss > channel > title"]
+		assert.Equal(t, []byte("<title>Liftoff News</title>"), data[o.Start:o.End])
+	})
+	t.Run("test parse with offsets - atom:link", func(t *testing.T) {
+		data := []byte(`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Title</title>` +
+			`<link>https://example.com</link>` +
+			`<atom:link href="https://example.com/feed" rel="self"/>` +
+			`<atom:link href="https://example.com/hub" rel="hub"/>` +
+			`</channel></rss>`)
+		r, offsets, err := ParseWithOffsets(bytes.NewReader(data))
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com", r.Channel.LinkString())
+		assert.Equal(t, 2, len(r.Channel.AtomLink))
+		o := offsets["rss > channel > link"]
+		assert.Equal(t, "<link>https://example.com</link>", string(data[o.Start:o.End]))
+		o = offsets["rss > channel > atom:link[0]"]
+		assert.Equal(t, `<atom:link href="https://example.com/feed" rel="self"/>`, string(data[o.Start:o.End]))
+		o = offsets["rss > channel > atom:link[1]"]
+		assert.Equal(t, `<atom:link href="https://example.com/hub" rel="hub"/>`, string(data[o.Start:o.End]))
+	})
+}
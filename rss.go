@@ -22,6 +22,9 @@
 //     the first struct field that has tag ",chardata". The struct field may
 //     have type []byte or string. If there is no such field, the character
 //     data is discarded.
+//   - CDATA sections (e.g. <![CDATA[https://example.com]]>) are accumulated
+//     as character data without the enclosing markup. When marshaling,
+//     character data is escaped and never wrapped in a CDATA section.
 //   - A field with a tag including the "omitempty" option is omitted if the
 //     field value is empty. The empty values are false, 0, any nil pointer or
 //     interface value, and any array, slice, map, or string of length zero.
@@ -30,12 +33,30 @@ package rss
 import (
 	"encoding/xml"
 	"fmt"
+	"html"
+	"io"
 	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 )
 
 const RSSVERSION = "2.0"
 
+// The version of this package, which is reported in the User-Agent of requests
+// made by it (e.g. FetchPaginated).
+const PACKAGEVERSION = "0.1.0"
+
+// The namespace of RSS 2.0 elements, which may optionally be declared as the
+// default namespace of an RSS document.
+//
+// See: http://backend.userland.com/rss2
+const RSSNAMESPACE = "http://backend.userland.com/rss2"
+
+// The media type of an RSS document, used as the Content-Type when serving it.
+const CONTENTTYPE = "application/rss+xml; charset=utf-8"
+
 // The RSSElement interface specifies a single method, IsValid. IsValid checks
 // whether the element conforms to the RSS 2.0 Specification.
 type RSSElement interface {
@@ -53,7 +74,17 @@ type RSSElement interface {
 // RSSElement, the IsValid method is called. Each RSSElement is responsible for
 // implementing its IsValid method in accordance with the RSS 2.0
 // Specification.
+//
+// Nil pointers, zero-valued structs, and zero-valued optional ("omitempty")
+// fields represent absent elements and are skipped. Absent required elements
+// are reported by their parent element.
 func Validate(r RSSElement) (bool, []error) {
+	return validateFields(r, validateOptions{})
+}
+
+// Validates the struct fields of 'r' as described by Validate, as configured
+// by 'o' (see ValidateWith).
+func validateFields(r RSSElement, o validateOptions) (bool, []error) {
 	isValid, errs := true, []error{}
 	// ValueOf returns a new Value initialized to the concrete value
 	// stored in the interface i. ValueOf(nil) returns the zero Value.
@@ -82,7 +113,16 @@ func Validate(r RSSElement) (bool, []error) {
 		//
 		// If not, ok will be false and t will be the zero value of type T, and no
 		// panic occurs.
+		//
+		// Unexported fields (e.g. the child elements recorded by Enclosure) are
+		// not RSS elements and are skipped, as Interface would panic.
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
 		if t, ok := v.Field(i).Interface().(RSSElement); ok {
+			// The name of the element, used to look up custom validators.
+			name := qualifiedName(v.Type().Field(i))
+			optional := strings.Contains(v.Type().Field(i).Tag.Get("xml"), ",omitempty")
 			// ValueOf returns a new Value initialized to the concrete value
 			// stored in the interface i. ValueOf(nil) returns the zero Value.
 			v := reflect.ValueOf(t)
@@ -94,7 +134,31 @@ func Validate(r RSSElement) (bool, []error) {
 					continue
 				}
 			}
-			if ok, e := t.IsValid(); !ok {
+			// A zero-valued struct represents an absent element. Absent required
+			// elements are reported by the parent element (e.g. <channel>).
+			if v.Kind() == reflect.Struct && v.IsZero() {
+				continue
+			}
+			// A zero-valued optional element (e.g. <width> of <image>) is absent.
+			if optional && v.IsZero() {
+				continue
+			}
+			// A valid element may still return warnings (see Warning).
+			ok, e := isValidWith(t, o)
+			if !ok {
+				isValid = false
+			}
+			errs = append(errs, e...)
+			// Custom validators are run after the built-in checks. They are
+			// always passed a pointer to the element, so a field that is not a
+			// pointer (e.g. Title of Channel) is passed as a pointer to a copy.
+			p := t
+			if v.Kind() != reflect.Pointer {
+				pv := reflect.New(v.Type())
+				pv.Elem().Set(v)
+				p = pv.Interface().(RSSElement)
+			}
+			if ok, e := runValidators(name, p); !ok {
 				isValid = false
 				errs = append(errs, e...)
 			}
@@ -103,6 +167,86 @@ func Validate(r RSSElement) (bool, []error) {
 	return isValid, errs
 }
 
+// Returns whether the XML representation of a single element is valid and a
+// slice containing any errors.
+//
+// 'data' is unmarshaled into the RSSElement corresponding to 'tagName' before
+// calling its IsValid method. This allows validating a snippet (e.g.
+// <pubDate>...</pubDate>) without wrapping it in a full RSS document.
+//
+// Extension elements are named by their conventional prefix (e.g.
+// "atom:link" or "dc:date"). Their snippet must declare the namespace of the
+// element (e.g. <atom:link xmlns:atom="http://www.w3.org/2005/Atom" ... />).
+func ValidateElementXML(tagName string, data []byte) (bool, []error) {
+	var r RSSElement
+	switch tagName {
+	case "rss":
+		r = &RSS{}
+	case "channel":
+		r = &Channel{}
+	case "title":
+		r = &Title{}
+	case "link":
+		r = &Link{}
+	case "description":
+		r = &Description{}
+	case "pubDate":
+		r = &PubDate{}
+	case "lastBuildDate":
+		r = &LastBuildDate{}
+	case "category":
+		r = &Category{}
+	case "cloud":
+		r = &Cloud{}
+	case "ttl":
+		r = &TTL{}
+	case "image":
+		r = &Image{}
+	case "textInput":
+		r = &TextInput{}
+	case "name":
+		r = &Name{}
+	case "skipHours":
+		r = &SkipHours{}
+	case "skipDays":
+		r = &SkipDays{}
+	case "item":
+		r = &Item{}
+	case "source":
+		r = &Source{}
+	case "enclosure":
+		r = &Enclosure{}
+	case "guid":
+		r = &GUID{}
+	case "comments":
+		r = &Comments{}
+	case "author":
+		r = &Author{}
+	case "atom:link":
+		r = &AtomLink{}
+	case "atom:updated":
+		r = &AtomUpdated{}
+	case "dc:date":
+		r = &DCDate{}
+	case "dc:creator":
+		r = &DCCreator{}
+	case "itunes:author":
+		r = &ITunesAuthor{}
+	case "itunes:owner":
+		r = &ITunesOwner{}
+	case "itunes:explicit":
+		r = &ITunesExplicit{}
+	default:
+		msg := fmt.Sprintf("Element <%s> is invalid", tagName)
+		return false, []error{fmt.Errorf("%s: %w", msg, ErrUnknownElement)}
+	}
+	if err := xml.Unmarshal(data, r); err != nil {
+		msg := fmt.Sprintf("Element <%s> is invalid", tagName)
+		return false, []error{fmt.Errorf("%s: %v", msg, err)}
+	}
+	return r.IsValid()
+}
+
 // At the top level, a RSS document is a <rss> element, with a mandatory
 // attribute called version, that specifies the version of RSS that the
 // document conforms to. If it conforms to this specification, the version
@@ -113,107 +257,340 @@ func Validate(r RSSElement) (bool, []error) {
 //
 // See:
 //   - https://validator.w3.org/feed/docs/rss2.html#whatIsRss
+//
+// XMLComment holds any XML comments that are direct children of <rss> (see
+// WithComments). It is not an RSS element.
+//
+// Charset is the character set of the document from which the RSS document
+// was parsed (e.g. "ISO-8859-1"), if it was not UTF-8 (see
+// WithOriginalCharset). It is not an RSS element.
 type RSS struct {
-	XMLName xml.Name `xml:"rss"`          // required
-	Version Version  `xml:"version,attr"` // required
-	Channel *Channel `xml:"channel"`      // required
+	XMLName    xml.Name `xml:"rss"`          // required
+	Version    Version  `xml:"version,attr"` // required
+	XMLComment string   `xml:",comment"`     // optional
+	Charset    string   `xml:"-"`            // optional
+	Channel    *Channel `xml:"channel"`      // required
 }
 
-// Whether <rss> is valid.
-func (r RSS) IsValid() (bool, []error) { return Validate(r) }
+// Returns whether <rss> is valid and a slice containing any errors.
+//
+// <channel> and each of its sub-elements, including every <item>, are
+// validated, so the errors cover the whole document.
+func (r RSS) IsValid() (bool, []error) {
+	return r.validate(validateOptions{})
+}
+
+func (r RSS) validate(o validateOptions) (bool, []error) {
+	isValid, errs := true, []error{}
+	// RSS elements are not in a namespace. However, some documents declare the
+	// RSS 2.0 namespace as the default namespace. Any other namespace (e.g.
+	// xmlns="http://www.w3.org/2005/Atom") indicates the document is not RSS.
+	if r.XMLName.Space != "" && r.XMLName.Space != RSSNAMESPACE {
+		msg := fmt.Sprintf("Element <%s> namespace '%s' is invalid", r.XMLName.Local, r.XMLName.Space)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, ErrInvalidNamespace))
+	}
+	ok, e := validateFields(r, o)
+	if !ok {
+		isValid = false
+	}
+	errs = append(errs, e...)
+	if ok, e := runValidators("rss", &r); !ok {
+		isValid = false
+		errs = append(errs, e...)
+	}
+	return isValid, errs
+}
 
 // version is a required attribute of <rss>.
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#whatIsRss
 type Version string
 
-// Whether version is valid.
+// Returns whether version is valid and a slice containing any errors.
 //
 // <rss> must contain "version" attribute with value "2.0".
 //
 // NOTE: A version 0.91 or 0.92 file is also a valid 2.0 file.
-func (r Version) IsValid() bool {
-	return r == RSSVERSION
+func (r Version) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	if r == "" {
+		msg := "Attribute 'version' of <rss> is required"
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, ErrInvalidElement))
+	} else if r != RSSVERSION {
+		msg := fmt.Sprintf("Attribute 'version' of <rss> value '%s' is invalid", r)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be \"%s\"", msg, ErrInvalidValue, RSSVERSION))
+	}
+	return isValid, errs
 }
 
 // <channel> is a required sub-element of <rss>.
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#requiredChannelElements
+//
+// XMLComment holds any XML comments that are direct children of <channel>
+// (see WithComments). It is distinct from the <comments> sub-element of
+// <item> and is not an RSS element.
+//
+// encoding/xml marshals struct fields in declaration order. The fields of
+// Channel are therefore declared in canonical order: required elements first,
+// then optional elements, then extension elements (e.g. <atom:link>), then
+// all <item>s. Do not reorder them.
+//
+// NOTE: A field without a namespace (e.g. `xml:"link"`) matches an element in
+// any namespace, so <atom:link>s are decoded by UnmarshalXML rather than by
+// field order, and other extension elements are skipped.
 type Channel struct {
-	XMLName        xml.Name       `xml:"channel"`                  // required
-	Title          Title          `xml:"title"`                    // required
-	Link           Link           `xml:"link"`                     // required
-	Description    Description    `xml:"description"`              // required
-	Language       Language       `xml:"language,omitempty"`       // optional
-	Copyright      Copyright      `xml:"copyright,omitempty"`      // optional
-	ManagingEditor ManagingEditor `xml:"managingEditor,omitempty"` // optional
-	WebMaster      WebMaster      `xml:"webMaster,omitempty"`      // optional
-	PubDate        PubDate        `xml:"pubDate,omitempty"`        // optional
-	LastBuildDate  LastBuildDate  `xml:"lastBuildDate,omitempty"`  // optional
-	Category       Category       `xml:"category,omitempty"`       // optional
-	Generator      Generator      `xml:"generator,omitempty"`      // optional
-	Docs           Docs           `xml:"docs,omitempty"`           // optional
-	Cloud          Cloud          `xml:"cloud,omitempty"`          // optional
-	TTL            TTL            `xml:"ttl,omitempty"`            // optional
-	Image          Image          `xml:"image,omitempty"`          // optional
-	Rating         Rating         `xml:"rating,omitempty"`         // optional
-	TextInput      TextInput      `xml:"textInput,omitempty"`      // optional
-	SkipHours      SkipHours      `xml:"skipHours,omitempty"`      // optional
-	SkipDays       SkipDays       `xml:"skipDays,omitempty"`       // optional
-	Item           []*Item        `xml:"item,omitempty"`           // optional
-}
-
-// Whether <channel> is valid.
+	XMLName        xml.Name        `xml:"channel"`                                                       // required
+	XMLComment     string          `xml:",comment"`                                                      // optional
+	Title          Title           `xml:"title"`                                                         // required
+	Link           Link            `xml:"link"`                                                          // required
+	Description    Description     `xml:"description"`                                                   // required
+	Language       Language        `xml:"language,omitempty"`                                            // optional
+	Copyright      Copyright       `xml:"copyright,omitempty"`                                           // optional
+	ManagingEditor ManagingEditor  `xml:"managingEditor,omitempty"`                                      // optional
+	WebMaster      WebMaster       `xml:"webMaster,omitempty"`                                           // optional
+	PubDate        *PubDate        `xml:"pubDate,omitempty"`                                             // optional
+	LastBuildDate  *LastBuildDate  `xml:"lastBuildDate,omitempty"`                                       // optional
+	Category       []*Category     `xml:"category,omitempty"`                                            // optional
+	Generator      Generator       `xml:"generator,omitempty"`                                           // optional
+	Docs           Docs            `xml:"docs,omitempty"`                                                // optional
+	Cloud          *Cloud          `xml:"cloud,omitempty"`                                               // optional
+	TTL            *TTL            `xml:"ttl,omitempty"`                                                 // optional
+	Image          *Image          `xml:"image,omitempty"`                                               // optional
+	Rating         Rating          `xml:"rating,omitempty"`                                              // optional
+	TextInput      *TextInput      `xml:"textInput,omitempty"`                                           // optional
+	SkipHours      *SkipHours      `xml:"skipHours,omitempty"`                                           // optional
+	SkipDays       *SkipDays       `xml:"skipDays,omitempty"`                                            // optional
+	AtomLink       []*AtomLink     `xml:"http://www.w3.org/2005/Atom link,omitempty"`                    // optional
+	ITunesOwner    *ITunesOwner    `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd owner,omitempty"`    // optional
+	ITunesExplicit *ITunesExplicit `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd explicit,omitempty"` // optional
+	Item           []*Item         `xml:"item,omitempty"`                                                // optional
+}
+
+// Unmarshals <channel>, decoding each <atom:link> into AtomLink rather than
+// Link, and <itunes:owner> and <itunes:explicit> into ITunesOwner and
+// ITunesExplicit. Other sub-elements in a namespace other than that of RSS 2.0
+// are skipped (see elementReader).
+func (r *Channel) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	// channel has the fields of Channel, but not its UnmarshalXML method.
+	type channel Channel
+	er := &elementReader{d: d, start: &start, decoders: map[xml.Name]func(*xml.StartElement) error{
+		{Space: ATOMNAMESPACE, Local: "link"}: func(t *xml.StartElement) error {
+			link := &AtomLink{}
+			if err := d.DecodeElement(link, t); err != nil {
+				return err
+			}
+			r.AtomLink = append(r.AtomLink, link)
+			return nil
+		},
+		{Space: ITUNESNAMESPACE, Local: "owner"}: func(t *xml.StartElement) error {
+			r.ITunesOwner = &ITunesOwner{}
+			return d.DecodeElement(r.ITunesOwner, t)
+		},
+		{Space: ITUNESNAMESPACE, Local: "explicit"}: func(t *xml.StartElement) error {
+			r.ITunesExplicit = &ITunesExplicit{}
+			return d.DecodeElement(r.ITunesExplicit, t)
+		},
+	}}
+	return xml.NewTokenDecoder(er).Decode((*channel)(r))
+}
+
+// An elementReader is an xml.TokenReader that returns 'start' and the tokens
+// of the element read from 'd', other than those of its sub-elements in a
+// namespace other than that of RSS 2.0 (see isCoreNamespace).
+//
+// A field without a namespace (e.g. `xml:"image"`) matches an element in any
+// namespace, so extension elements (e.g. <itunes:image>) would otherwise be
+// decoded into RSS elements of the same name. Instead, each such sub-element
+// is decoded by its function in 'decoders' or, if there is none, skipped.
+type elementReader struct {
+	d        *xml.Decoder
+	start    *xml.StartElement
+	space    string
+	depth    int
+	decoders map[xml.Name]func(*xml.StartElement) error
+}
+
+// Returns whether an element in namespace 'space', whose parent element is in
+// namespace 'parent', is an RSS element rather than an extension element.
+//
+// RSS elements are in no namespace or in the RSS 2.0 namespace. However, a
+// document may declare any default namespace on <rss> (e.g.
+// xmlns="http://example.com/ns"), in which case its RSS elements are in the
+// same namespace as their parent.
+func isCoreNamespace(space, parent string) bool {
+	return space == "" || space == RSSNAMESPACE || space == parent
+}
+
+func (r *elementReader) Token() (xml.Token, error) {
+	if r.start != nil {
+		start := r.start.Copy()
+		r.start, r.space, r.depth = nil, start.Name.Space, 1
+		return start, nil
+	}
+	for r.depth > 0 {
+		tok, err := r.d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if r.depth == 1 && !isCoreNamespace(t.Name.Space, r.space) {
+				decode, ok := r.decoders[t.Name]
+				if !ok {
+					decode = func(*xml.StartElement) error { return r.d.Skip() }
+				}
+				if err := decode(&t); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			r.depth++
+		case xml.EndElement:
+			r.depth--
+		}
+		return tok, nil
+	}
+	return nil, io.EOF
+}
+
+// Returns whether <channel> is valid and a slice containing any errors.
 //
 // In order for <channel> to be valid, it must comprise all required elements
 // and sub-elements.
 //
 // If <channel> contains optional sub-elements with required elements, these
-// too must be valid.
-//
-// To accomplish this, we recurse through all struct fields. If the struct
-// field is of interface type RSSElement, the IsValid method is called. Each
-// RSSElement is responsible for implementing its IsValid method in accordance
-// with the RSS 2.0 Specification.
-func (r Channel) IsValid() bool {
-	// ValueOf returns a new Value initialized to the concrete value
-	// stored in the interface i. ValueOf(nil) returns the zero Value.
-	v := reflect.ValueOf(r)
-	// NumField returns the number of fields in the struct v.
-	// It panics if v's Kind is not Struct.
-	for i := 0; i < v.NumField(); i++ {
-		// Field returns the i'th field of the struct v.
-		// It panics if v's Kind is not Struct or i is out of range.
-		//
-		// Interface returns v's current value as an interface{}.
-		// It is equivalent to:
-		//
-		//	var i interface{} = (v's underlying value)
-		//
-		// It panics if the Value was obtained by accessing
-		// unexported struct fields.
-		//
-		// To test whether an interface value holds a specific type, a type
-		// assertion can return two values: the underlying value and a boolean
-		// value that reports whether the assertion succeeded.
-		//
-		//  t, ok := i.(T)
-		//
-		// If i holds a T, then t will be the underlying value and ok will be true.
-		//
-		// If not, ok will be false and t will be the zero value of type T, and no
-		// panic occurs.
-		if t, ok := v.Field(i).Interface().(RSSElement); ok {
-			// Indirect returns the value that v points to.
-			// If v is a nil pointer, Indirect returns a zero Value.
-			// If v is not a pointer, Indirect returns v.
-			v := reflect.Indirect(reflect.ValueOf(t))
-			if v.IsNil() || !v.IsValid() {
-				return false
+// too must be valid. Each <item> of <channel> must also be valid.
+func (r Channel) IsValid() (bool, []error) {
+	return r.validate(validateOptions{})
+}
+
+func (r Channel) validate(o validateOptions) (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <%s> is invalid", r.XMLName.Local)
+	// <channel> contains three required sub-elements: <title>, <link>,
+	// <description>
+	if reflect.ValueOf(r.Title).IsZero() || reflect.ValueOf(r.Link).IsZero() || reflect.ValueOf(r.Description).IsZero() {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: <title>, <link> and <description> must be present", msg, ErrInvalidElement))
+	}
+	// Under WithRequireLanguage, <language> is required.
+	if r.Language == "" && o.requireLanguage {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: <language> must be present", msg, ErrInvalidElement))
+	} else if r.Language != "" {
+		if ok, e := r.Language.validate(o); !ok {
+			isValid = false
+			errs = append(errs, e...)
+		}
+	}
+	if r.Rating != "" {
+		if ok, e := r.Rating.validate(o); !ok {
+			isValid = false
+			errs = append(errs, e...)
+		}
+	}
+	// <skipHours> and <skipDays> are validated below, so that their errors are
+	// prefixed with their path.
+	v := r
+	v.SkipHours, v.SkipDays = nil, nil
+	ok, e := validateFields(v, o)
+	if !ok {
+		isValid = false
+	}
+	errs = append(errs, e...)
+	// Errors of <skipHours> are prefixed with its path (e.g.
+	// "channel > skipHours > hour[2]: ...").
+	if r.SkipHours != nil {
+		ok, e := r.SkipHours.IsValid()
+		if okv, ev := runValidators("skipHours", r.SkipHours); !okv {
+			ok = false
+			e = append(e, ev...)
+		}
+		if !ok {
+			isValid = false
+			for _, err := range e {
+				errs = append(errs, fmt.Errorf("channel > skipHours > %w", err))
 			}
 		}
 	}
-	return true
+	// Errors of <skipDays> are prefixed with its path (e.g.
+	// "channel > skipDays > day[2]: ...").
+	if r.SkipDays != nil {
+		ok, e := r.SkipDays.IsValid()
+		if okv, ev := runValidators("skipDays", r.SkipDays); !okv {
+			ok = false
+			e = append(e, ev...)
+		}
+		if !ok {
+			isValid = false
+			for _, err := range e {
+				errs = append(errs, fmt.Errorf("channel > skipDays > %w", err))
+			}
+		}
+	}
+	// <channel> may contain more than one <atom:link>.
+	for _, link := range r.AtomLink {
+		if link == nil {
+			continue
+		}
+		if ok, e := link.validate(o); !ok {
+			isValid = false
+			errs = append(errs, e...)
+		}
+		if ok, e := runValidators("atom:link", link); !ok {
+			isValid = false
+			errs = append(errs, e...)
+		}
+	}
+	// <channel> may contain more than one <category>.
+	for i, category := range r.Category {
+		if category == nil {
+			continue
+		}
+		ok, e := category.IsValid()
+		if okv, ev := runValidators("category", category); !okv {
+			ok = false
+			e = append(e, ev...)
+		}
+		if !ok {
+			isValid = false
+			for _, err := range e {
+				errs = append(errs, fmt.Errorf("channel > category[%d]: %w", i, err))
+			}
+		}
+	}
+	// Errors of an <item> are prefixed with its path and, if present, its
+	// <guid> or <link> (e.g. "channel > item[2] (guid '1337'): ..."), so that
+	// the <item> can be identified.
+	for i, item := range r.Item {
+		if item == nil {
+			continue
+		}
+		ok, e := item.validate(o)
+		if okv, ev := runValidators("item", item); !okv {
+			ok = false
+			e = append(e, ev...)
+		}
+		if !ok {
+			isValid = false
+		}
+		if len(e) > 0 {
+			prefix := fmt.Sprintf("channel > item[%d]", i)
+			if item.GUID != nil && len(item.GUID.CharData) > 0 {
+				prefix = fmt.Sprintf("%s (guid '%s')", prefix, item.GUID.CharData)
+			} else if item.Link != nil && len(item.Link.CharData) > 0 {
+				prefix = fmt.Sprintf("%s (link '%s')", prefix, item.Link.CharData)
+			}
+			for _, err := range e {
+				errs = append(errs, fmt.Errorf("%s: %w", prefix, err))
+			}
+		}
+	}
+	return isValid, errs
 }
 
 // <title> is a required sub-element of <channel>, <textInput>, and <item>.
@@ -238,6 +615,14 @@ func (r Title) IsValid() (bool, []error) {
 	return isValid, errs
 }
 
+// Returns the text of <title> with HTML entities decoded once (e.g. "AT&T"
+// for "AT&amp;T").
+//
+// Character data is already unescaped by encoding/xml, so this is only
+// necessary for titles whose entities are encoded twice (e.g.
+// <title>AT&amp;amp;T</title>), which is a common generator bug.
+func (r *Title) DecodedText() string { return html.UnescapeString(string(r.CharData)) }
+
 // <link> is a required sub-element of <channel>, <image>, <textInput>, and
 // <item>.
 //
@@ -266,6 +651,12 @@ func (r Link) IsValid() (bool, []error) {
 	return isValid, errs
 }
 
+func (r Link) validate(o validateOptions) (bool, []error) {
+	isValid, errs := r.IsValid()
+	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
+	return o.requireHTTPS(isValid, errs, msg, string(r.CharData))
+}
+
 // <description> is a required sub-element of <channel> and <textInput> and an
 // optional sub-element of <image> and <item>
 //
@@ -306,6 +697,25 @@ type Language string
 //   - https://www.loc.gov/standards/iso639-2
 func (r Language) IsValid() bool { return true }
 
+// Matches a language code (ISO 639), optionally followed by subtags (e.g. a
+// country code), as used by <language> (e.g. "en-us").
+//
+// See: https://www.rssboard.org/rss-language-codes
+var languageCode = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{1,8})*$`)
+
+// Returns whether <language> is valid and a slice containing any errors.
+//
+// Under WithRequireLanguage, <language> must be a language code.
+func (r Language) validate(o validateOptions) (bool, []error) {
+	isValid, errs := true, []error{}
+	if o.requireLanguage && !languageCode.MatchString(string(r)) {
+		msg := fmt.Sprintf("Element <language> value '%s' is invalid", r)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be a language code (ISO 639)", msg, ErrInvalidValue))
+	}
+	return isValid, errs
+}
+
 // <copyright> is an optional sub-element of <channel>.
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#optionalChannelElements
@@ -361,6 +771,32 @@ func (r PubDate) IsValid() (bool, []error) {
 	return isValid, errs
 }
 
+func (r PubDate) validate(o validateOptions) (bool, []error) {
+	if o.dateLocale != "" {
+		if _, err := o.parseDate(string(r.CharData)); err == nil {
+			return true, []error{}
+		}
+	}
+	return o.lenientDate(r.IsValid())
+}
+
+// Returns the time of <pubDate>.
+//
+// Under WithDateLocale, a <pubDate> in the given locale is parsed. Under
+// WithLenientDates, an unparsable <pubDate> returns the zero time and no error.
+// Other options are ignored.
+func (r PubDate) Time(opts ...ValidateOption) (time.Time, error) {
+	o := validateOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	t, err := o.parseDate(string(r.CharData))
+	if err != nil && o.lenientDates {
+		return time.Time{}, nil
+	}
+	return t, err
+}
+
 // <lastBuildDate> is an optional sub-element of <channel>.
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#optionalChannelElements
@@ -390,6 +826,15 @@ func (r LastBuildDate) IsValid() (bool, []error) {
 	return isValid, errs
 }
 
+func (r LastBuildDate) validate(o validateOptions) (bool, []error) {
+	if o.dateLocale != "" {
+		if _, err := o.parseDate(string(r.CharData)); err == nil {
+			return true, []error{}
+		}
+	}
+	return o.lenientDate(r.IsValid())
+}
+
 // <category> is an optional sub-element of <channel> and <item>.
 //
 // The <channel>-level category element follows the same rules as the
@@ -421,10 +866,36 @@ func (r Category) IsValid() (bool, []error) {
 			isValid = false
 			errs = append(errs, fmt.Errorf("%s: %w", msg, err))
 		}
+		// 'domain' is commonly a URL identifying the categorization taxonomy, but
+		// may also be an opaque identifier (e.g. "dmoz"). Only values that look
+		// like a URI are required to be a valid URI.
+		if strings.Contains(*r.Domain, "://") {
+			if ok, err := IsValidURI(*r.Domain); !ok {
+				isValid = false
+				errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+			}
+		}
 	}
 	return isValid, errs
 }
 
+// Returns the forward-slash-separated segments of <category>.
+//
+// Example:
+//
+//	<category>News/World</category>
+//
+// has segments "News" and "World". Empty segments are discarded.
+func (r Category) Segments() []string {
+	segments := []string{}
+	for _, s := range strings.Split(string(r.CharData), "/") {
+		if s = strings.TrimSpace(s); s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
+
 // 'domain' is an optional attribute of <category> and a required attribute of
 // <cloud>.
 //
@@ -465,7 +936,7 @@ type Cloud struct {
 // Returns whether <cloud> is valid and a slice containing any errors.
 func (r Cloud) IsValid() (bool, []error) {
 	isValid, errs := true, []error{}
-	msg := fmt.Sprintf("Element <%s> is invalid", r.XMLName.Local)
+	msg := fmt.Sprintf("Element <%s> must be empty but contains '%s'", r.XMLName.Local, r.CharData)
 	if ok, err := IsEmpty(string(r.CharData)); !ok {
 		isValid = false
 		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
@@ -531,6 +1002,30 @@ func (r Cloud) IsValid() (bool, []error) {
 	return isValid, errs
 }
 
+// The procedure names accepted for each protocol of <cloud> under
+// WithStrictCloud.
+//
+// See:
+//   - http://xmlrpc.com/spec.md
+//   - https://www.w3.org/TR/xml-names/#NT-NCName
+var registerProcedures = map[string]*regexp.Regexp{
+	"xml-rpc": regexp.MustCompile(`^[A-Za-z0-9_.:/]+$`),
+	"soap":    regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`),
+}
+
+func (r Cloud) validate(o validateOptions) (bool, []error) {
+	isValid, errs := r.IsValid()
+	if !o.strictCloud || r.Protocol == nil || r.RegisterProcedure == nil || *r.RegisterProcedure == "" {
+		return isValid, errs
+	}
+	if re, ok := registerProcedures[*r.Protocol]; ok && !re.MatchString(*r.RegisterProcedure) {
+		msg := fmt.Sprintf("Attribute 'registerProcedure' of <%s> value '%s' is invalid", r.XMLName.Local, *r.RegisterProcedure)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be a valid procedure name for protocol \"%s\"", msg, ErrInvalidValue, *r.Protocol))
+	}
+	return isValid, errs
+}
+
 // 'port' is required attribute of <cloud>.
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#ltcloudgtSubelementOfLtchannelgt
@@ -581,29 +1076,56 @@ func (r TTL) IsValid() (bool, []error) {
 //
 // TODO: Set default values for width and height.
 type Image struct {
-	XMLName     xml.Name    `xml:"image"`                 // required
-	URL         URL         `xml:"url"`                   // required
-	Title       Title       `xml:"title"`                 // required
-	Link        Link        `xml:"link"`                  // required
-	Width       Width       `xml:"width,omitempty"`       // optional
-	Height      Height      `xml:"height,omitempty"`      // optional
-	Description Description `xml:"description,omitempty"` // optional
+	XMLName     xml.Name     `xml:"image"`                 // required
+	URL         URL          `xml:"url"`                   // required
+	Title       Title        `xml:"title"`                 // required
+	Link        Link         `xml:"link"`                  // required
+	Width       Width        `xml:"width,omitempty"`       // optional
+	Height      Height       `xml:"height,omitempty"`      // optional
+	Description *Description `xml:"description,omitempty"` // optional
 }
 
-// Whether <image> is valid.
+// Returns whether <image> is valid and a slice containing any errors.
 func (r Image) IsValid() (bool, []error) {
-	return true, nil
-	// // Required sub-elements: <url>, <title>, <link>
-	// //
-	// // NOTE: In practice the image <title> and <link> should have the same value
-	// // as the channel's <title> and <link>.
-	//
-	//	if r.URL.IsValid() || r.Title.IsValid() || r.Link.IsValid() {
-	//		return false
-	//	}
+	return r.validate(validateOptions{})
+}
+
+func (r Image) validate(o validateOptions) (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <%s> is invalid", r.XMLName.Local)
+	// <image> contains three required sub-elements: <url>, <title>, <link>
 	//
-	// // Optional sub-elements: <width>, <height>, <description>
-	// return r.Width.IsValid() && r.Height.IsValid()
+	// NOTE: In practice the image <title> and <link> should have the same value
+	// as the channel's <title> and <link>.
+	if r.URL == nil {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: <url> must be present", msg, ErrInvalidElement))
+	} else {
+		msg := fmt.Sprintf("Element <url> value '%s' is invalid", *r.URL)
+		if ok, err := IsNotEmpty(*r.URL); !ok {
+			isValid = false
+			errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+		} else if ok, err := IsValidURI(*r.URL); !ok {
+			isValid = false
+			errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+		}
+		isValid, errs = o.requireHTTPS(isValid, errs, msg, *r.URL)
+	}
+	if reflect.ValueOf(r.Title).IsZero() {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: <title> must be present", msg, ErrInvalidElement))
+	}
+	if reflect.ValueOf(r.Link).IsZero() {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: <link> must be present", msg, ErrInvalidElement))
+	}
+	// <image> contains three optional sub-elements: <width>, <height>,
+	// <description>. These are only validated if present.
+	if ok, e := validateFields(r, o); !ok {
+		isValid = false
+		errs = append(errs, e...)
+	}
+	return isValid, errs
 }
 
 // <url> is a required sub-element of <image>.
@@ -621,14 +1143,17 @@ type URL *string
 // See: https://validator.w3.org/feed/docs/rss2.html#ltimagegtSubelementOfLtchannelgt
 type Width string
 
-// Whether <width> is valid.
+// Returns whether <width> is valid and a slice containing any errors.
 //
 // The maximum value for width is 144, default value is 88.
-func (r Width) IsValid() bool {
+func (r Width) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <width> value '%s' is invalid", r)
 	if i, err := strconv.ParseUint(string(r), 10, 0); err != nil || i > 144 {
-		return false
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be a positive integer no greater than 144", msg, ErrInvalidValue))
 	}
-	return true
+	return isValid, errs
 }
 
 // <height> is an optional sub-element of <image>.
@@ -636,14 +1161,17 @@ func (r Width) IsValid() bool {
 // See: https://validator.w3.org/feed/docs/rss2.html#ltimagegtSubelementOfLtchannelgt
 type Height string
 
-// Whether <height> is valid.
+// Returns whether <height> is valid and a slice containing any errors.
 //
 // The maximum value for height is 400, default value is 31.
-func (r Height) IsValid() bool {
+func (r Height) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	msg := fmt.Sprintf("Element <height> value '%s' is invalid", r)
 	if i, err := strconv.ParseUint(string(r), 10, 0); err != nil || i > 400 {
-		return false
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be a positive integer no greater than 400", msg, ErrInvalidValue))
 	}
-	return true
+	return isValid, errs
 }
 
 // <rating> is an optional sub-element of <channel>.
@@ -654,6 +1182,25 @@ type Rating string
 // Whether <rating> is valid.
 func (r Rating) IsValid() bool { return true }
 
+// Matches a PICS label: the PICS version, the quoted URL of the rating
+// service, and the labels, each of which is in parentheses.
+//
+// See: https://www.w3.org/TR/REC-PICS-labels
+var picsLabel = regexp.MustCompile(`^\(PICS-1\.[01]\s+"[^"\s]+"\s+(?:[^()]*\s)?(?:l|labels)\s+[^()]*(?:\([^()]*\)\s*)+\)$`)
+
+// Returns whether <rating> is valid and a slice containing any errors.
+//
+// Under WithStrictRating, <rating> must be a PICS label.
+func (r Rating) validate(o validateOptions) (bool, []error) {
+	isValid, errs := true, []error{}
+	if o.strictRating && !picsLabel.MatchString(strings.TrimSpace(string(r))) {
+		msg := fmt.Sprintf("Element <rating> value '%s' is invalid", r)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be a PICS label", msg, ErrInvalidValue))
+	}
+	return isValid, errs
+}
+
 // <textInput> is an optional sub-element of <channel>.
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#lttextinputgtSubelementOfLtchannelgt
@@ -667,6 +1214,10 @@ type TextInput struct {
 
 // Returns whether <textInput> is valid and a slice containing any errors.
 func (r TextInput) IsValid() (bool, []error) {
+	return r.validate(validateOptions{})
+}
+
+func (r TextInput) validate(o validateOptions) (bool, []error) {
 	isValid, errs := true, []error{}
 	msg := fmt.Sprintf("Element <%s> is invalid", r.XMLName.Local)
 	// <textInput> contains four required sub-elements: <title>, <description>,
@@ -675,7 +1226,7 @@ func (r TextInput) IsValid() (bool, []error) {
 		isValid = false
 		errs = append(errs, fmt.Errorf("%s: %w: <title>, <description>, <name> and <link> must be present", msg, ErrInvalidElement))
 	}
-	if ok, e := Validate(r); !ok {
+	if ok, e := validateFields(r, o); !ok {
 		isValid = false
 		errs = append(errs, e...)
 	}
@@ -709,21 +1260,31 @@ type SkipHours struct {
 	Hour    []*Hour  `xml:"hour"`      // required
 }
 
-// Whether <skipHours> is valid.
+// Returns whether <skipHours> is valid and a slice containing any errors.
 //
 // This element contains up to 24 <hour> sub-elements whose value is a number
 // between 0 and 23.
-func (r SkipHours) IsValid() bool {
+func (r SkipHours) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
 	if len(r.Hour) > 24 {
-		return false
-	} else {
-		for _, h := range r.Hour {
-			if !h.IsValid() {
-				return false
+		msg := fmt.Sprintf("Element <%s> is invalid", r.XMLName.Local)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must contain no more than 24 <hour> sub-elements", msg, ErrInvalidElement))
+	}
+	// Errors of an <hour> are prefixed with its path within <skipHours> (e.g.
+	// "hour[2]: ...").
+	for i, h := range r.Hour {
+		if h == nil {
+			continue
+		}
+		if ok, e := h.IsValid(); !ok {
+			isValid = false
+			for _, err := range e {
+				errs = append(errs, fmt.Errorf("hour[%d]: %w", i, err))
 			}
 		}
 	}
-	return true
+	return isValid, errs
 }
 
 // <hour> is an optional sub-element of <skipHours>.
@@ -731,12 +1292,15 @@ func (r SkipHours) IsValid() bool {
 // See: https://validator.w3.org/feed/docs/rss2.html#optionalChannelElements
 type Hour int
 
-// Whether <hour> is valid.
-func (r Hour) IsValid() bool {
+// Returns whether <hour> is valid and a slice containing any errors.
+func (r Hour) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
 	if r < 0 || r > 23 {
-		return false
+		msg := fmt.Sprintf("Element <hour> value '%d' is invalid", r)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be an integer between 0 and 23", msg, ErrInvalidValue))
 	}
-	return true
+	return isValid, errs
 }
 
 // <skipDays> is an optional sub-element of <channel>.
@@ -744,24 +1308,34 @@ func (r Hour) IsValid() bool {
 // See: https://validator.w3.org/feed/docs/rss2.html#optionalChannelElements
 type SkipDays struct {
 	XMLName xml.Name `xml:"skipDays"` // required
-	Day     []*Day   `xml:"hour"`     // required
+	Day     []*Day   `xml:"day"`      // required
 }
 
-// Whether <skipDays> is valid.
+// Returns whether <skipDays> is valid and a slice containing any errors.
 //
 // This element contains up to seven <day> sub-elements whose value is
 // Monday, Tuesday, Wednesday, Thursday, Friday, Saturday or Sunday.
-func (r SkipDays) IsValid() bool {
+func (r SkipDays) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
 	if len(r.Day) > 7 {
-		return false
-	} else {
-		for _, d := range r.Day {
-			if !d.IsValid() {
-				return false
+		msg := fmt.Sprintf("Element <%s> is invalid", r.XMLName.Local)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must contain no more than 7 <day> sub-elements", msg, ErrInvalidElement))
+	}
+	// Errors of a <day> are prefixed with its path within <skipDays> (e.g.
+	// "day[2]: ...").
+	for i, d := range r.Day {
+		if d == nil {
+			continue
+		}
+		if ok, e := d.IsValid(); !ok {
+			isValid = false
+			for _, err := range e {
+				errs = append(errs, fmt.Errorf("day[%d]: %w", i, err))
 			}
 		}
 	}
-	return true
+	return isValid, errs
 }
 
 // <day> is an optional sub-element of <skipDays>.
@@ -769,10 +1343,19 @@ func (r SkipDays) IsValid() bool {
 // See: https://validator.w3.org/feed/docs/rss2.html#optionalChannelElements
 type Day string
 
-// Whether <day> is valid.
-//
-// TODO: Check if Monday - Sunday.
-func (r Day) IsValid() bool { return true }
+// Returns whether <day> is valid and a slice containing any errors.
+func (r Day) IsValid() (bool, []error) {
+	isValid, errs := true, []error{}
+	if !contains(days, string(r)) {
+		msg := fmt.Sprintf("Element <day> value '%s' is invalid", r)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w: must be one of %s", msg, ErrInvalidValue, strings.Join(days, ", ")))
+	}
+	return isValid, errs
+}
+
+// The valid values of <day>.
+var days = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
 
 // <item> is an optional sub-element of <channel>.
 //
@@ -783,31 +1366,106 @@ func (r Day) IsValid() bool { return true }
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#hrelementsOfLtitemgt
 type Item struct {
-	XMLName     xml.Name     `xml:"item"`                  // required
-	Title       *Title       `xml:"title,omitempty"`       // conditionally required
-	Link        *Link        `xml:"link,omitempty"`        // optional
-	Description *Description `xml:"description,omitempty"` // conditionally required
-	Source      *Source      `xml:"source,omitempty"`      // optional
-	Enclosure   *Enclosure   `xml:"enclosure,omitempty"`   // optional
-	Category    *Category    `xml:"category,omitempty"`    // optional
-	PubDate     *PubDate     `xml:"pubDate,omitempty"`     // optional
-	GUID        *GUID        `xml:"guid,omitempty"`        // optional
-	Comments    *Comments    `xml:"comments,omitempty"`    // optional
-	Author      *Author      `xml:"author,omitempty"`      // optional
+	XMLName        xml.Name        `xml:"item"`                                                          // required
+	Title          *Title          `xml:"title,omitempty"`                                               // conditionally required
+	Link           *Link           `xml:"link,omitempty"`                                                // optional
+	Description    *Description    `xml:"description,omitempty"`                                         // conditionally required
+	Source         *Source         `xml:"source,omitempty"`                                              // optional
+	Enclosure      *Enclosure      `xml:"enclosure,omitempty"`                                           // optional
+	Category       []*Category     `xml:"category,omitempty"`                                            // optional
+	PubDate        *PubDate        `xml:"pubDate,omitempty"`                                             // optional
+	GUID           *GUID           `xml:"guid,omitempty"`                                                // optional
+	Comments       *Comments       `xml:"comments,omitempty"`                                            // optional
+	Author         []*Author       `xml:"author,omitempty"`                                              // optional
+	DCDate         *DCDate         `xml:"http://purl.org/dc/elements/1.1/ date,omitempty"`               // optional
+	DCCreator      *DCCreator      `xml:"http://purl.org/dc/elements/1.1/ creator,omitempty"`            // optional
+	ITunesAuthor   *ITunesAuthor   `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd author,omitempty"`   // optional
+	ITunesExplicit *ITunesExplicit `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd explicit,omitempty"` // optional
+	AtomUpdated    *AtomUpdated    `xml:"http://www.w3.org/2005/Atom updated,omitempty"`                 // optional
+}
+
+// Unmarshals <item>, decoding <dc:date>, <dc:creator>, <itunes:author>,
+// <itunes:explicit>, and <atom:updated> into DCDate, DCCreator, ITunesAuthor,
+// ITunesExplicit, and AtomUpdated. Other sub-elements in a namespace other
+// than that of RSS 2.0 (e.g. <itunes:image>) are skipped (see elementReader).
+func (r *Item) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	// item has the fields of Item, but not its UnmarshalXML method.
+	type item Item
+	er := &elementReader{d: d, start: &start, decoders: map[xml.Name]func(*xml.StartElement) error{
+		{Space: DCNAMESPACE, Local: "date"}: func(t *xml.StartElement) error {
+			r.DCDate = &DCDate{}
+			return d.DecodeElement(r.DCDate, t)
+		},
+		{Space: DCNAMESPACE, Local: "creator"}: func(t *xml.StartElement) error {
+			r.DCCreator = &DCCreator{}
+			return d.DecodeElement(r.DCCreator, t)
+		},
+		{Space: ITUNESNAMESPACE, Local: "author"}: func(t *xml.StartElement) error {
+			r.ITunesAuthor = &ITunesAuthor{}
+			return d.DecodeElement(r.ITunesAuthor, t)
+		},
+		{Space: ITUNESNAMESPACE, Local: "explicit"}: func(t *xml.StartElement) error {
+			r.ITunesExplicit = &ITunesExplicit{}
+			return d.DecodeElement(r.ITunesExplicit, t)
+		},
+		{Space: ATOMNAMESPACE, Local: "updated"}: func(t *xml.StartElement) error {
+			r.AtomUpdated = &AtomUpdated{}
+			return d.DecodeElement(r.AtomUpdated, t)
+		},
+	}}
+	return xml.NewTokenDecoder(er).Decode((*item)(r))
 }
 
 // Returns whether <item> is valid and a slice containing any errors.
 func (r Item) IsValid() (bool, []error) {
+	return r.validate(validateOptions{})
+}
+
+func (r Item) validate(o validateOptions) (bool, []error) {
 	isValid, errs := true, []error{}
 	msg := fmt.Sprintf("Element <%s> is invalid", r.XMLName.Local)
-	// At least one of title or description must be present.
-	if (r.Title == nil || string(r.Title.CharData) == "") && (r.Description == nil || string(r.Description.CharData) == "") {
+	// At least one of title or description must be present. Under
+	// WithAllowEnclosureOnlyItems, a valid <enclosure> also suffices.
+	hasContent := (r.Title != nil && string(r.Title.CharData) != "") || (r.Description != nil && string(r.Description.CharData) != "")
+	if !hasContent && o.allowEnclosureOnlyItems && r.Enclosure != nil {
+		hasContent, _ = r.Enclosure.IsValid()
+	}
+	if !hasContent {
 		isValid = false
 		errs = append(errs, fmt.Errorf("%s: %w: one of <title> or <description> must be present", msg, ErrInvalidElement))
 	}
-	if ok, e := Validate(r); !ok {
+	ok, e := validateFields(r, o)
+	if !ok {
 		isValid = false
-		errs = append(errs, e...)
+	}
+	errs = append(errs, e...)
+	// <item> may contain more than one <category>.
+	for _, category := range r.Category {
+		if category == nil {
+			continue
+		}
+		if ok, e := category.IsValid(); !ok {
+			isValid = false
+			errs = append(errs, e...)
+		}
+		if ok, e := runValidators("category", category); !ok {
+			isValid = false
+			errs = append(errs, e...)
+		}
+	}
+	// <item> may contain more than one <author>.
+	for _, author := range r.Author {
+		if author == nil {
+			continue
+		}
+		if ok, e := author.IsValid(); !ok {
+			isValid = false
+			errs = append(errs, e...)
+		}
+		if ok, e := runValidators("author", author); !ok {
+			isValid = false
+			errs = append(errs, e...)
+		}
 	}
 	return isValid, errs
 }
@@ -842,6 +1500,15 @@ func (r Source) IsValid() (bool, []error) {
 	return isValid, errs
 }
 
+func (r Source) validate(o validateOptions) (bool, []error) {
+	isValid, errs := r.IsValid()
+	if r.URL == nil {
+		return isValid, errs
+	}
+	msg := fmt.Sprintf("Attribute 'url' of <%s> value '%s' is invalid", r.XMLName.Local, *r.URL)
+	return o.requireHTTPS(isValid, errs, msg, *r.URL)
+}
+
 // <enclosure> is an optional sub-element of <item>.
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#ltenclosuregtSubelementOfLtitemgt
@@ -869,16 +1536,56 @@ type Enclosure struct {
 	URL      URL      `xml:"url,attr"`    // required
 	Length   Length   `xml:"length,attr"` // required
 	Type     Type     `xml:"type,attr"`   // required
+	children []string // prohibited
+}
+
+// Unmarshals <enclosure>, recording the names of any child elements, which
+// are otherwise silently discarded by encoding/xml.
+func (r *Enclosure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	toks := []xml.Token{start.Copy()}
+	children := []string{}
+	for depth := 1; depth > 0; {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth == 1 {
+				children = append(children, t.Name.Local)
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+		toks = append(toks, xml.CopyToken(tok))
+	}
+	// enclosure has the fields of Enclosure, but not its UnmarshalXML method.
+	type enclosure Enclosure
+	var e enclosure
+	if err := xml.NewTokenDecoder(&tokenSlice{toks: toks}).Decode(&e); err != nil {
+		return err
+	}
+	*r = Enclosure(e)
+	if len(children) > 0 {
+		r.children = children
+	}
+	return nil
 }
 
 // Returns whether <enclosure> is valid and a slice containing any errors.
 func (r Enclosure) IsValid() (bool, []error) {
 	isValid, errs := true, []error{}
-	msg := fmt.Sprintf("Element <%s> is invalid", r.XMLName.Local)
+	msg := fmt.Sprintf("Element <%s> must be empty but contains '%s'", r.XMLName.Local, r.CharData)
 	if ok, err := IsEmpty(string(r.CharData)); !ok {
 		isValid = false
 		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
 	}
+	for _, child := range r.children {
+		msg := fmt.Sprintf("Element <%s> must be empty but contains <%s>", r.XMLName.Local, child)
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, ErrNonEmptyValue))
+	}
 	if r.URL == nil {
 		msg := fmt.Sprintf("Attribute 'url' of <%s> is required", r.XMLName.Local)
 		isValid = false
@@ -915,11 +1622,40 @@ func (r Enclosure) IsValid() (bool, []error) {
 		if ok, err := IsNotEmpty(*r.Type); !ok {
 			isValid = false
 			errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+		} else if _, _, err := normalizeMIME(*r.Type); err != nil {
+			// Parameters (e.g. "audio/mpeg; codecs=mp3") are permitted. Only the
+			// media type is validated.
+			isValid = false
+			errs = append(errs, fmt.Errorf("%s: %w", msg, err))
 		}
 	}
 	return isValid, errs
 }
 
+func (r Enclosure) validate(o validateOptions) (bool, []error) {
+	isValid, errs := r.IsValid()
+	if r.URL == nil {
+		return isValid, errs
+	}
+	msg := fmt.Sprintf("Attribute 'url' of <%s> value '%s' is invalid", r.XMLName.Local, *r.URL)
+	return o.requireHTTPS(isValid, errs, msg, *r.URL)
+}
+
+// Returns the media type of <enclosure> (e.g. "audio/mpeg" for
+// "audio/mpeg; codecs=mp3"), without parameters and in lowercase.
+func (r *Enclosure) MediaType() (string, error) {
+	if r.Type == nil {
+		msg := fmt.Sprintf("Attribute 'type' of <%s> is required", r.XMLName.Local)
+		return "", fmt.Errorf("%s: %w", msg, ErrInvalidElement)
+	}
+	mediatype, _, err := normalizeMIME(*r.Type)
+	if err != nil {
+		msg := fmt.Sprintf("Attribute 'type' of <%s> value '%s' is invalid", r.XMLName.Local, *r.Type)
+		return "", fmt.Errorf("%s: %w", msg, err)
+	}
+	return mediatype, nil
+}
+
 // 'length' is a required attribute of <enclosure>.
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#ltenclosuregtSubelementOfLtitemgt
@@ -937,6 +1673,10 @@ type Type *string
 //	<guid isPermaLink="true">https://example.com/1337</guid>
 //
 // See: https://validator.w3.org/feed/docs/rss2.html#ltguidgtSubelementOfLtitemgt
+//
+// NOTE: 'isPermaLink' is a pointer, so the "omitempty" option omits the
+// attribute only when it is nil. An explicit isPermaLink="false" is preserved
+// when marshaling.
 type GUID struct {
 	XMLName     xml.Name     `xml:"guid"`                       // required
 	CharData    []byte       `xml:",chardata"`                  // required
@@ -945,28 +1685,50 @@ type GUID struct {
 
 // Returns whether <guid> is valid and a slice containing any errors.
 func (r GUID) IsValid() (bool, []error) {
+	return r.validate(validateOptions{})
+}
+
+func (r GUID) validate(o validateOptions) (bool, []error) {
 	isValid, errs := true, []error{}
 	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
-	if ok, err := IsNotEmpty(string(r.CharData)); !ok {
+	// A <guid> containing only whitespace is effectively empty. Otherwise,
+	// surrounding whitespace can cause readers to treat the same item as
+	// distinct.
+	if ok, err := IsNotEmpty(r.Normalized()); !ok {
 		isValid = false
 		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
+	} else if r.Normalized() != string(r.CharData) {
+		isValid = false
+		errs = append(errs, fmt.Errorf("%s: %w", msg, ErrSurroundingWhitespace))
 	}
 	// If the <guid> element has an attribute named 'isPermaLink' with a value of
 	// "true", the reader may assume that it is a permalink to the item.
 	// 'isPermaLink' is optional. Its default value is true.
-	if r.IsPermaLink == nil || *r.IsPermaLink == "true" {
-		if ok, err := IsValidURI(string(r.CharData)); !ok {
+	isPermaLink := true
+	if r.IsPermaLink != nil {
+		isPermaLink, _ = parseBool(string(*r.IsPermaLink), o.lenientBooleans)
+	}
+	if isPermaLink {
+		if ok, err := IsValidURI(r.Normalized()); !ok {
 			isValid = false
 			errs = append(errs, fmt.Errorf("%s: %w", msg, err))
 		}
 	}
-	if ok, e := Validate(r); !ok {
+	if ok, e := validateFields(r, o); !ok {
 		isValid = false
 		errs = append(errs, e...)
 	}
 	return isValid, errs
 }
 
+// Returns the value of <guid> with leading and trailing whitespace removed.
+//
+// Readers compare guids to identify items, so surrounding whitespace can
+// cause the same item to be treated as distinct.
+func (r GUID) Normalized() string {
+	return strings.TrimSpace(string(r.CharData))
+}
+
 // 'isPermaLink' is an optional attribute of <guid>.
 //
 // NOTE: Its default value is true.
@@ -976,15 +1738,25 @@ type IsPermaLink string
 
 // Returns whether 'isPermaLink' is valid and a slice containing any errors.
 func (r IsPermaLink) IsValid() (bool, []error) {
+	return r.validate(validateOptions{})
+}
+
+func (r IsPermaLink) validate(o validateOptions) (bool, []error) {
 	isValid, errs := true, []error{}
 	msg := fmt.Sprintf("Attribute 'isPermaLink' of <guid> value '%s' is invalid", r)
-	if r != "true" && r != "false" {
+	if _, err := parseBool(string(r), o.lenientBooleans); err != nil {
 		isValid = false
-		errs = append(errs, fmt.Errorf("%s: %w: must be \"true\" or \"false\"", msg, ErrInvalidValue))
+		errs = append(errs, fmt.Errorf("%s: %w", msg, err))
 	}
 	return isValid, errs
 }
 
+// Returns the boolean value of 'isPermaLink', accepting the variants accepted
+// by WithLenientBooleans (e.g. "yes" is true).
+func (r IsPermaLink) Bool() (bool, error) {
+	return parseBool(string(r), true)
+}
+
 // <comments> is an optional sub-element of <item>.
 //
 // Example:
@@ -1012,6 +1784,12 @@ func (r Comments) IsValid() (bool, []error) {
 	return isValid, errs
 }
 
+func (r Comments) validate(o validateOptions) (bool, []error) {
+	isValid, errs := r.IsValid()
+	msg := fmt.Sprintf("Element <%s> value '%s' is invalid", r.XMLName.Local, r.CharData)
+	return o.requireHTTPS(isValid, errs, msg, string(r.CharData))
+}
+
 // <author> is an optional sub-element of <item>.
 //
 // Example:
@@ -1233,6 +1233,445 @@ func (tc ElementTestCase[T]) Test(t *testing.T) {
 
 func TestElement(t *testing.T) {
 	cases := []Testable{
+		// test <rss>
+		ElementTestCase[RSS]{
+			name:              "test <rss version=\"...\"> - ok",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: RSS{
+				XMLName: xml.Name{Space: "", Local: "rss"},
+				Version: Version("2.0"),
+			},
+		},
+		ElementTestCase[RSS]{
+			name:        "test <rss version=\"...\"> - fail - missing",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidElement},
+			wantErrorContains: []string{
+				"Attribute 'version' of <rss> is required: Element must contain " +
+					"required sub-elements and/or attributes",
+			},
+			r: RSS{
+				XMLName: xml.Name{Space: "", Local: "rss"},
+			},
+		},
+		ElementTestCase[RSS]{
+			name:        "test <rss version=\"...\"> - fail - unsupported",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidValue},
+			wantErrorContains: []string{
+				"Attribute 'version' of <rss> value '3.0' is invalid: Element or " +
+					"attribute must have valid value: must be \"2.0\"",
+			},
+			r: RSS{
+				XMLName: xml.Name{Space: "", Local: "rss"},
+				Version: Version("3.0"),
+			},
+		},
+		ElementTestCase[RSS]{
+			name:              "test <rss xmlns=\"...\"> - ok",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: RSS{
+				XMLName: xml.Name{Space: "http://backend.userland.com/rss2", Local: "rss"},
+				Version: Version("2.0"),
+			},
+		},
+		ElementTestCase[RSS]{
+			name:        "test <rss xmlns=\"...\"> - fail - atom",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidNamespace},
+			wantErrorContains: []string{
+				"Element <rss> namespace 'http://www.w3.org/2005/Atom' is invalid: " +
+					"Element must not be in a non-RSS namespace",
+			},
+			r: RSS{
+				XMLName: xml.Name{Space: "http://www.w3.org/2005/Atom", Local: "rss"},
+				Version: Version("2.0"),
+			},
+		},
+		// test <category>
+		ElementTestCase[Category]{
+			name:              "test <category domain=\"...\"> - ok - uri",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: Category{
+				XMLName:  xml.Name{Space: "", Local: "category"},
+				CharData: []byte("Category"),
+				Domain:   Ptr("https://example.com/cat"),
+			},
+		},
+		ElementTestCase[Category]{
+			name:              "test <category domain=\"...\"> - ok - opaque",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: Category{
+				XMLName:  xml.Name{Space: "", Local: "category"},
+				CharData: []byte("Category"),
+				Domain:   Ptr("dmoz"),
+			},
+		},
+		ElementTestCase[Category]{
+			name:        "test <category domain=\"...\"> - fail - invalid uri",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidURI},
+			wantErrorContains: []string{
+				"Attribute 'domain' of <category> value 'http://bad uri' is " +
+					"invalid: Element must contain a valid URI (RFC3986): parse " +
+					"\"http://bad uri\": invalid character \" \" in host name",
+			},
+			r: Category{
+				XMLName:  xml.Name{Space: "", Local: "category"},
+				CharData: []byte("Category"),
+				Domain:   Ptr("http://bad uri"),
+			},
+		},
+		// test <image>
+		ElementTestCase[Image]{
+			name:              "test <image> - ok",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: Image{
+				XMLName: xml.Name{Space: "", Local: "image"},
+				URL:     Ptr("https://example.com/image.png"),
+				Title: Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Title"),
+				},
+				Link: Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("https://example.com"),
+				},
+				Width:  Width("88"),
+				Height: Height("31"),
+				Description: &Description{
+					XMLName:  xml.Name{Space: "", Local: "description"},
+					CharData: []byte("Description"),
+				},
+			},
+		},
+		ElementTestCase[Image]{
+			name:        "test <image> - fail - empty description",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrEmptyValue},
+			wantErrorContains: []string{
+				"Element <description> value '' is invalid: Element must not have " +
+					"empty value",
+			},
+			r: Image{
+				XMLName: xml.Name{Space: "", Local: "image"},
+				URL:     Ptr("https://example.com/image.png"),
+				Title: Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Title"),
+				},
+				Link: Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("https://example.com"),
+				},
+				Description: &Description{
+					XMLName:  xml.Name{Space: "", Local: "description"},
+					CharData: []byte(""),
+				},
+			},
+		},
+		ElementTestCase[Image]{
+			name:        "test <image> - fail - invalid width and height",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidValue, ErrInvalidValue},
+			wantErrorContains: []string{
+				"Element <width> value '145' is invalid: Element or attribute must " +
+					"have valid value: must be a positive integer no greater than 144",
+				"Element <height> value '401' is invalid: Element or attribute must " +
+					"have valid value: must be a positive integer no greater than 400",
+			},
+			r: Image{
+				XMLName: xml.Name{Space: "", Local: "image"},
+				URL:     Ptr("https://example.com/image.png"),
+				Title: Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Title"),
+				},
+				Link: Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("https://example.com"),
+				},
+				Width:  Width("145"),
+				Height: Height("401"),
+			},
+		},
+		ElementTestCase[Image]{
+			name:        "test <image> - fail - missing url",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidElement},
+			wantErrorContains: []string{
+				"Element <image> is invalid: Element must contain required " +
+					"sub-elements and/or attributes: <url> must be present",
+			},
+			r: Image{
+				XMLName: xml.Name{Space: "", Local: "image"},
+				Title: Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Title"),
+				},
+				Link: Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("https://example.com"),
+				},
+			},
+		},
+		ElementTestCase[Image]{
+			name:        "test <image> - fail - missing title",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidElement},
+			wantErrorContains: []string{
+				"Element <image> is invalid: Element must contain required " +
+					"sub-elements and/or attributes: <title> must be present",
+			},
+			r: Image{
+				XMLName: xml.Name{Space: "", Local: "image"},
+				URL:     Ptr("https://example.com/image.png"),
+				Link: Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("https://example.com"),
+				},
+			},
+		},
+		ElementTestCase[Image]{
+			name:        "test <image> - fail - missing link",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidElement},
+			wantErrorContains: []string{
+				"Element <image> is invalid: Element must contain required " +
+					"sub-elements and/or attributes: <link> must be present",
+			},
+			r: Image{
+				XMLName: xml.Name{Space: "", Local: "image"},
+				URL:     Ptr("https://example.com/image.png"),
+				Title: Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Title"),
+				},
+			},
+		},
+		ElementTestCase[Image]{
+			name:        "test <image> - fail - empty url",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrEmptyValue},
+			wantErrorContains: []string{
+				"Element <url> value '' is invalid: Element must not have empty value",
+			},
+			r: Image{
+				XMLName: xml.Name{Space: "", Local: "image"},
+				URL:     Ptr(""),
+				Title: Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Title"),
+				},
+				Link: Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("https://example.com"),
+				},
+			},
+		},
+		ElementTestCase[Image]{
+			name:        "test <image> - fail - invalid url",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidURI},
+			wantErrorContains: []string{
+				"Element <url> value 'bad uri' is invalid: Element must contain a " +
+					"valid URI (RFC3986)",
+			},
+			r: Image{
+				XMLName: xml.Name{Space: "", Local: "image"},
+				URL:     Ptr("bad uri"),
+				Title: Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Title"),
+				},
+				Link: Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("https://example.com"),
+				},
+			},
+		},
+		// test <textInput>
+		ElementTestCase[TextInput]{
+			name:              "test <textInput> - ok",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: TextInput{
+				XMLName: xml.Name{Space: "", Local: "textInput"},
+				Title: &Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Search"),
+				},
+				Description: &Description{
+					XMLName:  xml.Name{Space: "", Local: "description"},
+					CharData: []byte("Search this site"),
+				},
+				Name: &Name{
+					XMLName:  xml.Name{Space: "", Local: "name"},
+					CharData: []byte("q"),
+				},
+				Link: &Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("https://example.com/search"),
+				},
+			},
+		},
+		ElementTestCase[TextInput]{
+			name:        "test <textInput> - fail - invalid link",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidURI},
+			wantErrorContains: []string{
+				"Element <link> value 'bad uri' is invalid: Element must contain a " +
+					"valid URI (RFC3986)",
+			},
+			r: TextInput{
+				XMLName: xml.Name{Space: "", Local: "textInput"},
+				Title: &Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Search"),
+				},
+				Description: &Description{
+					XMLName:  xml.Name{Space: "", Local: "description"},
+					CharData: []byte("Search this site"),
+				},
+				Name: &Name{
+					XMLName:  xml.Name{Space: "", Local: "name"},
+					CharData: []byte("q"),
+				},
+				Link: &Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("bad uri"),
+				},
+			},
+		},
+		ElementTestCase[TextInput]{
+			name:        "test <textInput> - fail - empty name",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrEmptyValue},
+			wantErrorContains: []string{
+				"Element <name> value '' is invalid: Element must not have empty " +
+					"value",
+			},
+			r: TextInput{
+				XMLName: xml.Name{Space: "", Local: "textInput"},
+				Title: &Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Search"),
+				},
+				Description: &Description{
+					XMLName:  xml.Name{Space: "", Local: "description"},
+					CharData: []byte("Search this site"),
+				},
+				Name: &Name{
+					XMLName:  xml.Name{Space: "", Local: "name"},
+					CharData: []byte(""),
+				},
+				Link: &Link{
+					XMLName:  xml.Name{Space: "", Local: "link"},
+					CharData: []byte("https://example.com/search"),
+				},
+			},
+		},
+		// test <skipHours>
+		ElementTestCase[SkipHours]{
+			name:              "test <skipHours> - ok",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: SkipHours{
+				XMLName: xml.Name{Space: "", Local: "skipHours"},
+				Hour:    []*Hour{Ptr(Hour(0)), Ptr(Hour(23))},
+			},
+		},
+		ElementTestCase[SkipHours]{
+			name:        "test <skipHours> - fail - invalid hour",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidValue},
+			wantErrorContains: []string{
+				"hour[1]: Element <hour> value '24' is " +
+					"invalid: Element or attribute must have valid value: must be an " +
+					"integer between 0 and 23",
+			},
+			r: SkipHours{
+				XMLName: xml.Name{Space: "", Local: "skipHours"},
+				Hour:    []*Hour{Ptr(Hour(1)), Ptr(Hour(24))},
+			},
+		},
+		ElementTestCase[SkipHours]{
+			name:        "test <skipHours> - fail - too many hours",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidElement},
+			wantErrorContains: []string{
+				"Element <skipHours> is invalid: Element must contain required " +
+					"sub-elements and/or attributes: must contain no more than 24 " +
+					"<hour> sub-elements",
+			},
+			r: SkipHours{
+				XMLName: xml.Name{Space: "", Local: "skipHours"},
+				Hour: []*Hour{
+					Ptr(Hour(0)), Ptr(Hour(1)), Ptr(Hour(2)), Ptr(Hour(3)),
+					Ptr(Hour(4)), Ptr(Hour(5)), Ptr(Hour(6)), Ptr(Hour(7)),
+					Ptr(Hour(8)), Ptr(Hour(9)), Ptr(Hour(10)), Ptr(Hour(11)),
+					Ptr(Hour(12)), Ptr(Hour(13)), Ptr(Hour(14)), Ptr(Hour(15)),
+					Ptr(Hour(16)), Ptr(Hour(17)), Ptr(Hour(18)), Ptr(Hour(19)),
+					Ptr(Hour(20)), Ptr(Hour(21)), Ptr(Hour(22)), Ptr(Hour(23)),
+					Ptr(Hour(0)),
+				},
+			},
+		},
+		// test <skipDays>
+		ElementTestCase[SkipDays]{
+			name:              "test <skipDays> - ok",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: SkipDays{
+				XMLName: xml.Name{Space: "", Local: "skipDays"},
+				Day:     []*Day{Ptr(Day("Saturday")), Ptr(Day("Sunday"))},
+			},
+		},
+		ElementTestCase[SkipDays]{
+			name:        "test <skipDays> - fail - invalid day",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidValue},
+			wantErrorContains: []string{
+				"day[2]: Element <day> value 'Funday' is " +
+					"invalid: Element or attribute must have valid value: must be one " +
+					"of Monday, Tuesday, Wednesday, Thursday, Friday, Saturday, Sunday",
+			},
+			r: SkipDays{
+				XMLName: xml.Name{Space: "", Local: "skipDays"},
+				Day:     []*Day{Ptr(Day("Saturday")), Ptr(Day("Sunday")), Ptr(Day("Funday"))},
+			},
+		},
+		ElementTestCase[SkipDays]{
+			name:        "test <skipDays> - fail - too many days",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidElement},
+			wantErrorContains: []string{
+				"Element <skipDays> is invalid: Element must contain required " +
+					"sub-elements and/or attributes: must contain no more than 7 " +
+					"<day> sub-elements",
+			},
+			r: SkipDays{
+				XMLName: xml.Name{Space: "", Local: "skipDays"},
+				Day: []*Day{
+					Ptr(Day("Monday")), Ptr(Day("Tuesday")), Ptr(Day("Wednesday")),
+					Ptr(Day("Thursday")), Ptr(Day("Friday")), Ptr(Day("Saturday")),
+					Ptr(Day("Sunday")), Ptr(Day("Monday")),
+				},
+			},
+		},
 		// test <guid>
 		ElementTestCase[GUID]{
 			name:              "test <guid> - ok",
@@ -1267,6 +1706,42 @@ func TestElement(t *testing.T) {
 				IsPermaLink: Ptr(IsPermaLink("false")),
 			},
 		},
+		ElementTestCase[GUID]{
+			name:              "test <guid> - ok - tag uri",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: GUID{
+				XMLName:     xml.Name{Space: "", Local: "guid"},
+				CharData:    []byte("tag:example.com,2024:1"),
+				IsPermaLink: nil,
+			},
+		},
+		ElementTestCase[GUID]{
+			name:              "test <guid> - ok - uuid urn",
+			wantIsValid:       true,
+			wantErrorIs:       []error{},
+			wantErrorContains: []string{},
+			r: GUID{
+				XMLName:     xml.Name{Space: "", Local: "guid"},
+				CharData:    []byte("urn:uuid:3f2504e0-4f89-11d3-9a0c-0305e82c3301"),
+				IsPermaLink: nil,
+			},
+		},
+		ElementTestCase[GUID]{
+			name:        "test <guid> - fail - bare uuid",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrInvalidURI},
+			wantErrorContains: []string{
+				"Element <guid> value '3f2504e0-4f89-11d3-9a0c-0305e82c3301' is " +
+					"invalid: Element must contain a valid URI (RFC3986)",
+			},
+			r: GUID{
+				XMLName:     xml.Name{Space: "", Local: "guid"},
+				CharData:    []byte("3f2504e0-4f89-11d3-9a0c-0305e82c3301"),
+				IsPermaLink: nil,
+			},
+		},
 		ElementTestCase[GUID]{
 			name:        "test <guid> - fail - empty",
 			wantIsValid: false,
@@ -1283,6 +1758,34 @@ func TestElement(t *testing.T) {
 				IsPermaLink: nil,
 			},
 		},
+		ElementTestCase[GUID]{
+			name:        "test <guid> - fail - whitespace",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrEmptyValue},
+			wantErrorContains: []string{
+				"Element <guid> value '   ' is invalid: Element must not have " +
+					"empty value",
+			},
+			r: GUID{
+				XMLName:     xml.Name{Space: "", Local: "guid"},
+				CharData:    []byte("   "),
+				IsPermaLink: Ptr(IsPermaLink("false")),
+			},
+		},
+		ElementTestCase[GUID]{
+			name:        "test <guid> - fail - surrounding whitespace",
+			wantIsValid: false,
+			wantErrorIs: []error{ErrSurroundingWhitespace},
+			wantErrorContains: []string{
+				"Element <guid> value ' https://example.com/1337\n' is invalid: " +
+					"Element must not have leading or trailing whitespace",
+			},
+			r: GUID{
+				XMLName:     xml.Name{Space: "", Local: "guid"},
+				CharData:    []byte(" https://example.com/1337\n"),
+				IsPermaLink: nil,
+			},
+		},
 		ElementTestCase[GUID]{
 			name:        "test <guid> - fail - invalid uri",
 			wantIsValid: false,
@@ -1468,6 +1971,23 @@ func TestParse(t *testing.T) {
 			},
 			r: GUID{},
 		},
+		ParseTestCase[Item]{
+			name: "test <item> <guid isPermaLink=\"false\"> - parse",
+			data: []byte(`<item><title>Title</title><guid isPermaLink="false">1337</guid></item>`),
+			want: Item{
+				XMLName: xml.Name{Space: "", Local: "item"},
+				Title: &Title{
+					XMLName:  xml.Name{Space: "", Local: "title"},
+					CharData: []byte("Title"),
+				},
+				GUID: &GUID{
+					XMLName:     xml.Name{Space: "", Local: "guid"},
+					CharData:    []byte("1337"),
+					IsPermaLink: Ptr(IsPermaLink("false")),
+				},
+			},
+			r: Item{},
+		},
 		ParseTestCase[Comments]{
 			name: "test <comments> - parse",
 			data: []byte(`<comments>https://example.com/comments</comments>`),
@@ -1491,3 +2011,336 @@ func TestParse(t *testing.T) {
 		tc.Test(t)
 	}
 }
+
+func TestValidateElementXML(t *testing.T) {
+	t.Run("test validate <pubDate> - ok", func(t *testing.T) {
+		ret, errs := ValidateElementXML("pubDate", []byte(`<pubDate>Tue, 10 Jun 2003 04:00:00 GMT</pubDate>`))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test validate <pubDate> - fail - invalid date", func(t *testing.T) {
+		ret, errs := ValidateElementXML("pubDate", []byte(`<pubDate>10 June 2003</pubDate>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], ErrInvalidDate)
+		assert.ErrorContains(t, errs[0], "Element <pubDate> value '10 June 2003' is invalid")
+	})
+	t.Run("test validate <enclosure> - ok", func(t *testing.T) {
+		ret, errs := ValidateElementXML("enclosure", []byte(`<enclosure url="https://example.com/audio.mp3" length="1337" type="audio/mpeg"></enclosure>`))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test validate <enclosure> - fail - missing attribute", func(t *testing.T) {
+		ret, errs := ValidateElementXML("enclosure", []byte(`<enclosure url="https://example.com/audio.mp3" length="1337"/>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], ErrInvalidElement)
+		assert.ErrorContains(t, errs[0], "Attribute 'type' of <enclosure> is required")
+	})
+	t.Run("test validate <channel> - ok", func(t *testing.T) {
+		ret, errs := ValidateElementXML("channel", []byte(`<channel><title>Title</title><link>https://example.com</link>`+
+			`<description>Description</description><item><title>Item</title></item></channel>`))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test validate <channel> - fail - missing description", func(t *testing.T) {
+		ret, errs := ValidateElementXML("channel", []byte(`<channel><title>Title</title><link>https://example.com</link></channel>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], ErrInvalidElement)
+		assert.ErrorContains(t, errs[0], "<title>, <link> and <description> must be present")
+	})
+	t.Run("test validate <skipHours> - ok", func(t *testing.T) {
+		ret, errs := ValidateElementXML("skipHours", []byte(`<skipHours><hour>0</hour><hour>23</hour></skipHours>`))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test validate <skipHours> - fail - invalid hour", func(t *testing.T) {
+		ret, errs := ValidateElementXML("skipHours", []byte(`<skipHours><hour>24</hour></skipHours>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		assert.ErrorContains(t, errs[0], "hour[0]: Element <hour> value '24' is invalid")
+		assert.NotContains(t, errs[0].Error(), "channel >")
+	})
+	t.Run("test validate <skipDays> - ok", func(t *testing.T) {
+		ret, errs := ValidateElementXML("skipDays", []byte(`<skipDays><day>Saturday</day><day>Sunday</day></skipDays>`))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test validate <skipDays> - fail - invalid day", func(t *testing.T) {
+		ret, errs := ValidateElementXML("skipDays", []byte(`<skipDays><day>Someday</day></skipDays>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		assert.ErrorContains(t, errs[0], "day[0]: Element <day> value 'Someday' is invalid")
+		assert.NotContains(t, errs[0].Error(), "channel >")
+	})
+	t.Run("test validate <atom:link> - ok", func(t *testing.T) {
+		ret, errs := ValidateElementXML("atom:link", []byte(`<atom:link xmlns:atom="http://www.w3.org/2005/Atom" href="https://example.com/rss.xml" rel="self"/>`))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test validate <atom:link> - fail - invalid href", func(t *testing.T) {
+		ret, errs := ValidateElementXML("atom:link", []byte(`<atom:link xmlns:atom="http://www.w3.org/2005/Atom" href="not a uri" rel="self"/>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], ErrInvalidURI)
+	})
+	t.Run("test validate <atom:link> - fail - missing namespace", func(t *testing.T) {
+		ret, errs := ValidateElementXML("atom:link", []byte(`<link href="https://example.com/rss.xml" rel="self"/>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorContains(t, errs[0], "Element <atom:link> is invalid")
+	})
+	t.Run("test validate <dc:date> - ok", func(t *testing.T) {
+		ret, errs := ValidateElementXML("dc:date", []byte(`<dc:date xmlns:dc="http://purl.org/dc/elements/1.1/">2003-06-10T04:00:00Z</dc:date>`))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test validate <dc:date> - fail - invalid date", func(t *testing.T) {
+		ret, errs := ValidateElementXML("dc:date", []byte(`<dc:date xmlns:dc="http://purl.org/dc/elements/1.1/">10 June 2003</dc:date>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+	})
+	t.Run("test validate - fail - mismatched element", func(t *testing.T) {
+		ret, errs := ValidateElementXML("pubDate", []byte(`<title>Title</title>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorContains(t, errs[0], "Element <pubDate> is invalid")
+	})
+	t.Run("test validate - fail - unknown element", func(t *testing.T) {
+		ret, errs := ValidateElementXML("unknown", []byte(`<unknown></unknown>`))
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], ErrUnknownElement)
+	})
+}
+
+func TestGUIDMarshalIsPermaLink(t *testing.T) {
+	cases := []struct {
+		name        string
+		isPermaLink *IsPermaLink
+		want        []byte
+	}{
+		{"nil", nil, []byte(`<guid>1337</guid>`)},
+		{"true", Ptr(IsPermaLink("true")), []byte(`<guid isPermaLink="true">1337</guid>`)},
+		{"false", Ptr(IsPermaLink("false")), []byte(`<guid isPermaLink="false">1337</guid>`)},
+		{"empty", Ptr(IsPermaLink("")), []byte(`<guid isPermaLink="">1337</guid>`)},
+	}
+	for _, tc := range cases {
+		t.Run("test <guid isPermaLink=\"...\"> - marshal - "+tc.name, func(t *testing.T) {
+			r := GUID{
+				XMLName:     xml.Name{Space: "", Local: "guid"},
+				CharData:    []byte("1337"),
+				IsPermaLink: tc.isPermaLink,
+			}
+			s, err := xml.Marshal(r)
+			assert.Equal(t, tc.want, s)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestCDATA(t *testing.T) {
+	cases := []struct {
+		r    RSSElement
+		data string
+	}{
+		{&Comments{}, `<comments><![CDATA[https://example.com/comments?a=1&b=2]]></comments>`},
+		{&Link{}, `<link><![CDATA[https://example.com/comments?a=1&b=2]]></link>`},
+	}
+	for _, tc := range cases {
+		t.Run("test CDATA - unmarshal - "+tc.data, func(t *testing.T) {
+			err := xml.Unmarshal([]byte(tc.data), tc.r)
+			assert.Nil(t, err)
+			ret, errs := tc.r.IsValid()
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+			s, err := xml.Marshal(tc.r)
+			assert.Nil(t, err)
+			assert.NotContains(t, string(s), "CDATA")
+			assert.Contains(t, string(s), "https://example.com/comments?a=1&amp;b=2")
+		})
+	}
+}
+
+func TestNonEmptyCharData(t *testing.T) {
+	cases := []struct {
+		r    RSSElement
+		want string
+	}{
+		{
+			Cloud{
+				XMLName:           xml.Name{Space: "", Local: "cloud"},
+				CharData:          []byte("junk"),
+				Domain:            Ptr("rpc.sys.com"),
+				Port:              Ptr("80"),
+				Path:              Ptr("/RPC2"),
+				RegisterProcedure: Ptr("pingMe"),
+				Protocol:          Ptr("soap"),
+			},
+			"Element <cloud> must be empty but contains 'junk': Element must not have value",
+		},
+		{
+			Enclosure{
+				XMLName:  xml.Name{Space: "", Local: "enclosure"},
+				CharData: []byte("junk"),
+				URL:      Ptr("https://example.com/audio.mp3"),
+				Length:   Ptr("1337"),
+				Type:     Ptr("audio/mpeg"),
+			},
+			"Element <enclosure> must be empty but contains 'junk': Element must not have value",
+		},
+	}
+	for _, tc := range cases {
+		t.Run("test non-empty char data - "+tc.want, func(t *testing.T) {
+			ret, errs := tc.r.IsValid()
+			assert.False(t, ret)
+			assert.Len(t, errs, 1)
+			assert.ErrorIs(t, errs[0], ErrNonEmptyValue)
+			assert.EqualError(t, errs[0], tc.want)
+		})
+	}
+}
+
+func TestEnclosureChildElements(t *testing.T) {
+	t.Run("test enclosure child elements", func(t *testing.T) {
+		var r Enclosure
+		err := xml.Unmarshal([]byte(`<enclosure url="https://example.com/audio.mp3" length="1" type="a/b"><extra/></enclosure>`), &r)
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com/audio.mp3", *r.URL)
+		assert.Equal(t, "1", *r.Length)
+		assert.Equal(t, "a/b", *r.Type)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrNonEmptyValue)
+		assert.EqualError(t, errs[0], "Element <enclosure> must be empty but contains <extra>: Element must not have value")
+	})
+	t.Run("test enclosure child elements - validate", func(t *testing.T) {
+		var r Enclosure
+		err := xml.Unmarshal([]byte(`<enclosure url="https://example.com/audio.mp3" length="1" type="a/b"><extra/></enclosure>`), &r)
+		assert.Nil(t, err)
+		assert.NotPanics(t, func() {
+			ret, errs := Validate(r)
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+		})
+	})
+	t.Run("test enclosure child elements - none", func(t *testing.T) {
+		var r Enclosure
+		err := xml.Unmarshal([]byte(`<enclosure url="https://example.com/audio.mp3" length="1" type="a/b"/>`), &r)
+		assert.Nil(t, err)
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+}
+
+func TestTitleDecodedText(t *testing.T) {
+	cases := []struct {
+		data string
+		want string
+	}{
+		{`<title>AT&amp;T</title>`, "AT&T"},
+		{`<title>AT&amp;amp;T</title>`, "AT&T"},
+		{`<title>AT&amp;amp;amp;T</title>`, "AT&amp;T"},
+		{`<title>Title</title>`, "Title"},
+	}
+	for _, tc := range cases {
+		t.Run("test title decoded text - "+tc.data, func(t *testing.T) {
+			var r Title
+			err := xml.Unmarshal([]byte(tc.data), &r)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, r.DecodedText())
+			// Marshaling does not double-escape the title.
+			s, err := xml.Marshal(r)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.data, string(s))
+		})
+	}
+}
+
+func TestCategorySegments(t *testing.T) {
+	cases := []struct {
+		data string
+		want []string
+	}{
+		{"News", []string{"News"}},
+		{"News/World", []string{"News", "World"}},
+		{"/News//World/", []string{"News", "World"}},
+		{"", []string{}},
+	}
+	for _, tc := range cases {
+		t.Run("test <category> - segments - "+tc.data, func(t *testing.T) {
+			r := Category{CharData: []byte(tc.data)}
+			assert.Equal(t, tc.want, r.Segments())
+		})
+	}
+}
+
+func TestGUIDNormalized(t *testing.T) {
+	cases := []struct {
+		data string
+		want string
+	}{
+		{"1337", "1337"},
+		{"  1337\n", "1337"},
+		{"   ", ""},
+	}
+	for _, tc := range cases {
+		t.Run("test <guid> - normalized - "+tc.data, func(t *testing.T) {
+			r := GUID{CharData: []byte(tc.data)}
+			assert.Equal(t, tc.want, r.Normalized())
+		})
+	}
+}
+
+func TestEnclosureMediaType(t *testing.T) {
+	cases := []struct {
+		typ  string
+		want string
+	}{
+		{"audio/mpeg", "audio/mpeg"},
+		{"Audio/MPEG; codecs=mp3", "audio/mpeg"},
+		{`video/mp4; codecs="avc1.42E01E, mp4a.40.2"`, "video/mp4"},
+	}
+	for _, tc := range cases {
+		t.Run("test <enclosure type=\"...\"> - media type - "+tc.typ, func(t *testing.T) {
+			r := Enclosure{
+				XMLName: xml.Name{Space: "", Local: "enclosure"},
+				URL:     Ptr("https://example.com/audio.mp3"),
+				Length:  Ptr("1337"),
+				Type:    Ptr(tc.typ),
+			}
+			s, err := r.MediaType()
+			assert.Equal(t, tc.want, s)
+			assert.Nil(t, err)
+			ret, errs := r.IsValid()
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+		})
+	}
+	t.Run("test <enclosure type=\"...\"> - media type - fail - invalid", func(t *testing.T) {
+		r := Enclosure{
+			XMLName: xml.Name{Space: "", Local: "enclosure"},
+			URL:     Ptr("https://example.com/audio.mp3"),
+			Length:  Ptr("1337"),
+			Type:    Ptr("audio"),
+		}
+		s, err := r.MediaType()
+		assert.Equal(t, "", s)
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "Attribute 'type' of <enclosure> value 'audio' is invalid")
+	})
+	t.Run("test <enclosure type=\"...\"> - media type - fail - nil", func(t *testing.T) {
+		r := Enclosure{XMLName: xml.Name{Space: "", Local: "enclosure"}}
+		_, err := r.MediaType()
+		assert.ErrorIs(t, err, ErrInvalidElement)
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// The rsstest package provides utilities for testing code that uses the rss
+// package.
+package rsstest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/nickolashkraus/rss"
+)
+
+// Asserts that an RSS document is valid and survives a round-trip through the
+// rss package without a change in validity.
+//
+// 'feed' is unmarshaled, marshaled, and unmarshaled again. 'feed' must be
+// valid, and the errors returned by IsValid must be the same before and after
+// marshaling. In particular, absent optional elements must not be emitted as
+// empty elements.
+func AssertRoundTripValid(t testing.TB, feed []byte) {
+	t.Helper()
+	before, err := rss.Parse(bytes.NewReader(feed))
+	if err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	data, err := xml.Marshal(before)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	after, err := rss.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("unmarshal after marshal: %v\n%s", err, data)
+	}
+	wantOK, wantErrs := before.IsValid()
+	if !wantOK {
+		t.Errorf("feed is not valid: %v", wantErrs)
+	}
+	gotOK, gotErrs := after.IsValid()
+	if gotOK != wantOK {
+		t.Errorf("validity changed after round-trip: got %t, want %t\nerrors: %v\n%s", gotOK, wantOK, gotErrs, data)
+		return
+	}
+	if len(gotErrs) != len(wantErrs) {
+		t.Errorf("errors changed after round-trip: got %v, want %v\n%s", gotErrs, wantErrs, data)
+		return
+	}
+	for i := range gotErrs {
+		if gotErrs[i].Error() != wantErrs[i].Error() {
+			t.Errorf("errors changed after round-trip: got %v, want %v\n%s", gotErrs, wantErrs, data)
+			return
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rsstest
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// A testing.TB that records failures instead of failing the test, so that the
+// failures of a helper can be asserted.
+type stubTB struct {
+	testing.TB
+	failed bool
+	fatal  bool
+	msgs   []string
+}
+
+func (t *stubTB) Helper() {}
+
+func (t *stubTB) Errorf(format string, args ...any) {
+	t.failed = true
+	t.msgs = append(t.msgs, fmt.Sprintf(format, args...))
+}
+
+func (t *stubTB) Fatalf(format string, args ...any) {
+	t.Errorf(format, args...)
+	t.fatal = true
+	runtime.Goexit()
+}
+
+// Runs AssertRoundTripValid on 'feed' with a stubTB and returns it. Since
+// Fatalf stops the goroutine that calls it, the helper is run in its own
+// goroutine.
+func runStub(feed string) *stubTB {
+	tb := &stubTB{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		AssertRoundTripValid(tb, []byte(feed))
+	}()
+	<-done
+	return tb
+}
+
+func TestAssertRoundTripValid(t *testing.T) {
+	t.Run("test round-trip - sample", func(t *testing.T) {
+		data, err := os.ReadFile("../test/data/samples/sample-rss-2.xml")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertRoundTripValid(t, data)
+	})
+	// The following optional sub-elements were previously declared as values,
+	// so that "omitempty" had no effect and absent elements were emitted as
+	// empty elements, which are invalid:
+	//   - <channel>: <pubDate>, <lastBuildDate>, <category>, <cloud>, <ttl>,
+	//     <image>, <textInput>, <skipHours>, <skipDays>
+	//   - <image>: <description>
+	t.Run("test round-trip - minimal channel", func(t *testing.T) {
+		AssertRoundTripValid(t, []byte(`<rss version="2.0"><channel><title>Title</title><link>https://example.com</link><description>Description</description><item><title>Item</title></item></channel></rss>`))
+	})
+	t.Run("test round-trip - image without description", func(t *testing.T) {
+		AssertRoundTripValid(t, []byte(`<rss version="2.0"><channel><title>Title</title><link>https://example.com</link><description>Description</description><image><url>https://example.com/image.png</url><title>Title</title><link>https://example.com</link></image></channel></rss>`))
+	})
+	t.Run("test round-trip - stub - ok", func(t *testing.T) {
+		tb := runStub(`<rss version="2.0"><channel><title>Title</title><link>https://example.com</link><description>Description</description></channel></rss>`)
+		if tb.failed {
+			t.Errorf("AssertRoundTripValid failed for a valid feed: %v", tb.msgs)
+		}
+	})
+	t.Run("test round-trip - stub - fail - invalid feed", func(t *testing.T) {
+		tb := runStub(`<rss version="2.0"><channel><title>Title</title><link>https://example.com</link><description>Description</description><ttl>never</ttl></channel></rss>`)
+		if !tb.failed || tb.fatal {
+			t.Fatalf("AssertRoundTripValid did not fail for an invalid feed: %v", tb.msgs)
+		}
+		if !strings.HasPrefix(tb.msgs[0], "feed is not valid: ") {
+			t.Errorf("unexpected failure: %v", tb.msgs)
+		}
+	})
+	t.Run("test round-trip - stub - fail - malformed feed", func(t *testing.T) {
+		tb := runStub(`<rss version="2.0"><channel><title>Title</channel></rss>`)
+		if !tb.failed || !tb.fatal {
+			t.Fatalf("AssertRoundTripValid did not fail for a feed that does not round-trip: %v", tb.msgs)
+		}
+		if !strings.HasPrefix(tb.msgs[0], "unmarshal: ") {
+			t.Errorf("unexpected failure: %v", tb.msgs)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Streaming functions for the rss package.
+package rss
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// An ItemDecoder reads the <item>s of an RSS document one at a time, so that
+// large documents need not be held in memory.
+//
+// The metadata of <channel> (i.e. all sub-elements other than <item>) is
+// buffered as it is read and is available from Channel. Metadata may appear
+// before, after, or between <item>s.
+type ItemDecoder struct {
+	d       *xml.Decoder
+	channel *Channel
+}
+
+// Returns a new ItemDecoder reading from 'r'.
+func NewItemDecoder(r io.Reader) *ItemDecoder {
+	d := xml.NewDecoder(r)
+	d.CharsetReader = newCharsetReader
+	return &ItemDecoder{d: d, channel: &Channel{}}
+}
+
+// Returns the next <item> of <channel> in document order. At the end of the
+// document, Next returns io.EOF.
+//
+// As with Parse, a sub-element of <channel> named "item" in a namespace other
+// than that of RSS 2.0 (e.g. <x:item>) is an extension element, not an <item>.
+func (r *ItemDecoder) Next() (*Item, error) {
+	for {
+		tok, err := r.d.Token()
+		if err != nil {
+			return nil, err
+		}
+		t, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		core := isCoreNamespace(t.Name.Space, r.channel.XMLName.Space)
+		switch {
+		case t.Name.Local == "rss" && r.channel.XMLName.Local == "":
+			continue
+		case t.Name.Local == "channel" && r.channel.XMLName.Local == "":
+			r.channel.XMLName = t.Name
+			continue
+		case t.Name.Local == "item" && core:
+			item := &Item{}
+			if err := r.d.DecodeElement(item, &t); err != nil {
+				return nil, err
+			}
+			return item, nil
+		default:
+			if err := r.decodeMetadata(t); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// Returns the metadata of <channel> read so far. Its Item field is always
+// empty. The metadata is complete once Next has returned io.EOF.
+func (r *ItemDecoder) Channel() *Channel { return r.channel }
+
+// Decodes the sub-element of <channel> starting with 'start' into the
+// metadata of <channel>.
+func (r *ItemDecoder) decodeMetadata(start xml.StartElement) error {
+	// The sub-element is wrapped in <channel>, in the namespace of the
+	// document's <channel>, so that extension elements are identified as they
+	// are by Parse (see elementReader).
+	name := r.channel.XMLName
+	if name.Local == "" {
+		name.Local = "channel"
+	}
+	toks := []xml.Token{xml.StartElement{Name: name}, start.Copy()}
+	for depth := 1; depth > 0; {
+		tok, err := r.d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+		toks = append(toks, xml.CopyToken(tok))
+	}
+	toks = append(toks, xml.EndElement{Name: name})
+	// Decoding into the existing Channel sets only the fields present in
+	// 'toks', leaving previously decoded metadata intact.
+	name = r.channel.XMLName
+	if err := xml.NewTokenDecoder(&tokenSlice{toks: toks}).Decode(r.channel); err != nil {
+		return err
+	}
+	r.channel.XMLName = name
+	return nil
+}
+
+// A tokenSlice is an xml.TokenReader that returns each of 'toks' in order.
+type tokenSlice struct {
+	toks []xml.Token
+}
+
+func (r *tokenSlice) Token() (xml.Token, error) {
+	if len(r.toks) == 0 {
+		return nil, io.EOF
+	}
+	tok := r.toks[0]
+	r.toks = r.toks[1:]
+	return tok, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemDecoder(t *testing.T) {
+	t.Run("test item decoder - interleaved metadata", func(t *testing.T) {
+		data := []byte(`<rss version="2.0"><channel><title>Title</title><item><title>First</title></item><description>Description</description><item><title>Second</title></item><link>https://example.com</link><ttl>60</ttl></channel></rss>`)
+		d := NewItemDecoder(bytes.NewReader(data))
+		titles := []string{}
+		for {
+			item, err := d.Next()
+			if err == io.EOF {
+				break
+			}
+			assert.Nil(t, err)
+			titles = append(titles, string(item.Title.CharData))
+		}
+		assert.Equal(t, []string{"First", "Second"}, titles)
+		c := d.Channel()
+		assert.Equal(t, "channel", c.XMLName.Local)
+		assert.Equal(t, "Title", c.TitleString())
+		assert.Equal(t, "Description", c.DescriptionString())
+		assert.Equal(t, "https://example.com", c.LinkString())
+		assert.Equal(t, "60", string(c.TTL.CharData))
+		assert.Empty(t, c.Item)
+		ret, errs := c.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test item decoder - sample", func(t *testing.T) {
+		data, err := os.ReadFile("test/data/samples/sample-rss-2.xml")
+		assert.Nil(t, err)
+		want, err := Parse(bytes.NewReader(data))
+		assert.Nil(t, err)
+		d := NewItemDecoder(bytes.NewReader(data))
+		items := []*Item{}
+		for {
+			item, err := d.Next()
+			if err == io.EOF {
+				break
+			}
+			assert.Nil(t, err)
+			items = append(items, item)
+		}
+		assert.Equal(t, want.Channel.Item, items)
+		c := d.Channel()
+		c.Item = want.Channel.Item
+		assert.Equal(t, want.Channel, c)
+	})
+	t.Run("test item decoder - namespaced item", func(t *testing.T) {
+		data := []byte(`<rss version="2.0" xmlns:x="http://example.com/x"><channel><title>Title</title>` +
+			`<x:item><title>Extension</title></x:item><item><title>First</title></item></channel></rss>`)
+		d := NewItemDecoder(bytes.NewReader(data))
+		titles := []string{}
+		for {
+			item, err := d.Next()
+			if err == io.EOF {
+				break
+			}
+			assert.Nil(t, err)
+			titles = append(titles, string(item.Title.CharData))
+		}
+		assert.Equal(t, []string{"First"}, titles)
+		assert.Equal(t, "Title", d.Channel().TitleString())
+	})
+	t.Run("test item decoder - default namespace", func(t *testing.T) {
+		data := []byte(`<rss version="2.0" xmlns="http://example.com/ns"><channel><title>Title</title>` +
+			`<item><title>First</title></item></channel></rss>`)
+		d := NewItemDecoder(bytes.NewReader(data))
+		item, err := d.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, "First", string(item.Title.CharData))
+		_, err = d.Next()
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, "Title", d.Channel().TitleString())
+	})
+	t.Run("test item decoder - fail - malformed", func(t *testing.T) {
+		d := NewItemDecoder(bytes.NewReader([]byte(`<rss><channel><item><title>`)))
+		_, err := d.Next()
+		assert.NotNil(t, err)
+		assert.NotEqual(t, io.EOF, err)
+	})
+}
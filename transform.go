@@ -0,0 +1,74 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Transforms for the rss package.
+package rss
+
+// A Transform modifies an RSS document in place (e.g. to remove tracking
+// parameters from its URLs).
+type Transform func(*RSS) error
+
+// Applies each of 'transforms' to the RSS document 'r' in order. If a
+// transform returns an error, the remaining transforms are not applied and
+// the error is returned.
+func ApplyTransforms(r *RSS, transforms ...Transform) error {
+	for _, transform := range transforms {
+		if err := transform(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns a Transform that removes the query parameters 'params' from every
+// URL in the RSS document (see RSS.StripQueryParams).
+func StripQueryParamsTransform(params ...string) Transform {
+	return func(r *RSS) error {
+		r.StripQueryParams(params...)
+		return nil
+	}
+}
+
+// A Transform that resolves relative URLs against the <link> of <item> or
+// <channel> (see RSS.ResolveLinks).
+func ResolveLinksTransform(r *RSS) error {
+	r.ResolveLinks()
+	return nil
+}
+
+// A Transform that removes optional attributes with an empty value (see
+// RSS.Prune).
+func PruneTransform(r *RSS) error {
+	r.Prune()
+	return nil
+}
+
+// A Transform that populates missing XML names (see RSS.EnsureXMLNames).
+func EnsureXMLNamesTransform(r *RSS) error {
+	r.EnsureXMLNames()
+	return nil
+}
+
+// A Transform that removes duplicate <category>s from each <item> (see
+// Item.DedupeCategories).
+func DedupeCategoriesTransform(r *RSS) error {
+	if r.Channel == nil {
+		return nil
+	}
+	for _, item := range r.Channel.Item {
+		if item != nil {
+			item.DedupeCategories()
+		}
+	}
+	return nil
+}
+
+// A Transform that returns a *ValidationError if the RSS document is invalid.
+// It is typically the last of a sequence of transforms.
+func ValidateTransform(r *RSS) error {
+	if ok, errs := r.IsValid(); !ok {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyTransforms(t *testing.T) {
+	newRSS := func() *RSS {
+		return &RSS{
+			Version: RSSVERSION,
+			Channel: &Channel{
+				Title:       Title{CharData: []byte("Title")},
+				Link:        Link{CharData: []byte("https://example.com/?utm_source=rss")},
+				Description: Description{CharData: []byte("Description")},
+				Item: []*Item{
+					{
+						Title:    &Title{CharData: []byte("Title")},
+						Link:     &Link{CharData: []byte("https://example.com/1?utm_medium=rss")},
+						Category: []*Category{{CharData: []byte("News")}, {CharData: []byte("News")}},
+					},
+				},
+			},
+		}
+	}
+	t.Run("test apply transforms", func(t *testing.T) {
+		r := newRSS()
+		err := ApplyTransforms(r, StripQueryParamsTransform(), DedupeCategoriesTransform, EnsureXMLNamesTransform, ValidateTransform)
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com/", r.Channel.LinkString())
+		assert.Equal(t, "https://example.com/1", string(r.Channel.Item[0].Link.CharData))
+		assert.Len(t, r.Channel.Item[0].Category, 1)
+		assert.Equal(t, "title", r.Channel.Item[0].Title.XMLName.Local)
+	})
+	t.Run("test apply transforms - resolve links", func(t *testing.T) {
+		r := newRSS()
+		r.Channel.Item[0].Link.CharData = []byte("/1?utm_medium=rss")
+		err := ApplyTransforms(r, ResolveLinksTransform, StripQueryParamsTransform())
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com/1", string(r.Channel.Item[0].Link.CharData))
+	})
+	t.Run("test apply transforms - none", func(t *testing.T) {
+		r := newRSS()
+		assert.Nil(t, ApplyTransforms(r))
+		assert.Equal(t, newRSS(), r)
+	})
+	t.Run("test apply transforms - fail - stops on error", func(t *testing.T) {
+		r := newRSS()
+		failed := errors.New("failed")
+		called := false
+		err := ApplyTransforms(r,
+			StripQueryParamsTransform("utm_source"),
+			func(*RSS) error { return failed },
+			func(*RSS) error { called = true; return nil },
+		)
+		assert.ErrorIs(t, err, failed)
+		assert.False(t, called)
+		assert.Equal(t, "https://example.com/", r.Channel.LinkString())
+	})
+	t.Run("test apply transforms - fail - invalid", func(t *testing.T) {
+		r := newRSS()
+		r.Channel.Description.CharData = nil
+		err := ApplyTransforms(r, EnsureXMLNamesTransform, ValidateTransform)
+		var verr *ValidationError
+		assert.ErrorAs(t, err, &verr)
+		assert.ErrorIs(t, err, ErrInvalidElement)
+	})
+}
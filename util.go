@@ -7,8 +7,10 @@ package rss
 
 import (
 	"fmt"
+	"mime"
 	"net/mail"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -39,14 +41,64 @@ func IsPositiveInteger(s string) (bool, error) {
 //
 // TODO: Valiate day of week.
 func IsValidDate(s string) (bool, error) {
-	if _, err := time.Parse(time.RFC822, s); err != nil {
-		if _, err := time.Parse(time.RFC1123, s); err != nil {
-			return false, fmt.Errorf("%w: %v", ErrInvalidDate, err)
-		}
+	if _, err := ParseDate(s); err != nil {
+		return false, err
 	}
 	return true, nil
 }
 
+// Parses 's' as a date (RFC822).
+//
+// The year may be expressed with two characters or four characters (four
+// preferred).
+func ParseDate(s string) (time.Time, error) {
+	t, _, err := parseDate(s)
+	return t, err
+}
+
+// The layouts accepted by ParseDate, in order of precedence. RFC822 permits
+// both named (e.g. "GMT") and numeric (e.g. "+0100") time zones.
+var dateLayouts = []string{time.RFC822, time.RFC1123, time.RFC822Z, time.RFC1123Z}
+
+// Parses 's' as a date (RFC822) and returns the layout with which it was
+// parsed (e.g. time.RFC1123).
+func parseDate(s string) (time.Time, string, error) {
+	var err error
+	for _, layout := range dateLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, layout, nil
+		}
+	}
+	return time.Time{}, "", fmt.Errorf("%w: %v", ErrInvalidDate, err)
+}
+
+// The layout of dates formatted by FormatDate. Dates are always expressed in
+// GMT, which is valid in both RFC822 and RFC1123.
+const dateLayout = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+// Formats 't' as a date (RFC822) with a four-character year
+// (e.g. "Mon, 02 Jan 2006 15:04:05 GMT").
+func FormatDate(t time.Time) string {
+	return t.UTC().Format(dateLayout)
+}
+
+// Parses the MIME type 's' (e.g. "audio/mpeg; codecs=mp3") and returns its
+// lowercase media type (e.g. "audio/mpeg") and parameters.
+//
+// An error is returned if 's' is not of the form "type/subtype", optionally
+// followed by parameters.
+func normalizeMIME(s string) (string, map[string]string, error) {
+	mediatype, params, err := mime.ParseMediaType(s)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: must be a valid MIME type: %v", ErrInvalidValue, err)
+	}
+	if i := strings.Index(mediatype, "/"); i <= 0 || i == len(mediatype)-1 {
+		return "", nil, fmt.Errorf("%w: must be a valid MIME type of the form \"type/subtype\"", ErrInvalidValue)
+	}
+	return mediatype, params, nil
+}
+
 // Whether 's' is a valid mail address (RFC5322).
 func IsValidMailAddress(s string) (bool, error) {
 	if _, err := mail.ParseAddress(s); err != nil {
@@ -62,3 +114,101 @@ func IsValidURI(s string) (bool, error) {
 	}
 	return true, nil
 }
+
+// Whether 'ss' contains 's'.
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// The names of weekdays (from Sunday, as time.Weekday) and months of a locale,
+// each in its full and abbreviated forms in lowercase.
+type dateNames struct {
+	weekdays [7][]string
+	months   [12][]string
+}
+
+// The locales whose dates are translated by translateDate, keyed by ISO 639
+// language code.
+var dateLocales = map[string]dateNames{
+	"fr": {
+		weekdays: [7][]string{
+			{"dimanche", "dim"}, {"lundi", "lun"}, {"mardi", "mar"}, {"mercredi", "mer"},
+			{"jeudi", "jeu"}, {"vendredi", "ven"}, {"samedi", "sam"},
+		},
+		months: [12][]string{
+			{"janvier", "janv", "jan"}, {"février", "févr", "fév", "fevrier", "fevr", "fev"},
+			{"mars", "mar"}, {"avril", "avr"}, {"mai"}, {"juin"}, {"juillet", "juil"},
+			{"août", "aout", "aoû"}, {"septembre", "sept", "sep"}, {"octobre", "oct"},
+			{"novembre", "nov"}, {"décembre", "déc", "decembre", "dec"},
+		},
+	},
+	"de": {
+		weekdays: [7][]string{
+			{"sonntag", "so"}, {"montag", "mo"}, {"dienstag", "di"}, {"mittwoch", "mi"},
+			{"donnerstag", "do"}, {"freitag", "fr"}, {"samstag", "sonnabend", "sa"},
+		},
+		months: [12][]string{
+			{"januar", "jan"}, {"februar", "feb"}, {"märz", "mär", "maerz", "mrz"}, {"april", "apr"},
+			{"mai"}, {"juni", "jun"}, {"juli", "jul"}, {"august", "aug"},
+			{"september", "sept", "sep"}, {"oktober", "okt"}, {"november", "nov"}, {"dezember", "dez"},
+		},
+	},
+	"es": {
+		weekdays: [7][]string{
+			{"domingo", "dom"}, {"lunes", "lun"}, {"martes", "mar"}, {"miércoles", "mié", "miercoles", "mie"},
+			{"jueves", "jue"}, {"viernes", "vie"}, {"sábado", "sáb", "sabado", "sab"},
+		},
+		months: [12][]string{
+			{"enero", "ene"}, {"febrero", "feb"}, {"marzo", "mar"}, {"abril", "abr"},
+			{"mayo", "may"}, {"junio", "jun"}, {"julio", "jul"}, {"agosto", "ago"},
+			{"septiembre", "setiembre", "sept", "sep"}, {"octubre", "oct"}, {"noviembre", "nov"},
+			{"diciembre", "dic"},
+		},
+	},
+}
+
+// Translates the names of the weekday and month of the date 's' in the locale
+// 'lang' (e.g. "fr" or "fr-FR") to their English abbreviations, such that
+// "ven., 01 mars 2024 11:30:00 GMT" becomes "Fri, 01 Mar 2024 11:30:00 GMT".
+//
+// Only the first word of 's' followed by a comma is taken to be a weekday, since
+// some abbreviations of weekdays and months are the same (e.g. "mar" is both
+// Tuesday and March in French). 's' is returned unchanged if 'lang' is not a
+// supported locale.
+func translateDate(s, lang string) string {
+	lang = strings.ToLower(lang)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	names, ok := dateLocales[lang]
+	if !ok {
+		return s
+	}
+	lookup := func(word string, table [][]string) (int, bool) {
+		word = strings.ToLower(strings.TrimSuffix(word, "."))
+		for i, forms := range table {
+			for _, form := range forms {
+				if word == form {
+					return i, true
+				}
+			}
+		}
+		return 0, false
+	}
+	fields := strings.Fields(s)
+	for i, field := range fields {
+		if i == 0 && strings.HasSuffix(field, ",") {
+			if d, ok := lookup(strings.TrimSuffix(field, ","), names.weekdays[:]); ok {
+				fields[i] = time.Weekday(d).String()[:3] + ","
+			}
+		} else if m, ok := lookup(field, names.months[:]); ok {
+			fields[i] = time.Month(m + 1).String()[:3]
+		}
+	}
+	return strings.Join(fields, " ")
+}
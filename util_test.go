@@ -3,3 +3,96 @@
 // license that can be found in the LICENSE file.
 
 package rss
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidDate(t *testing.T) {
+	t.Run("test is valid date - ok - two-digit year", func(t *testing.T) {
+		ok, err := IsValidDate("01 Jan 70 00:00 GMT")
+		assert.True(t, ok)
+		assert.Nil(t, err)
+	})
+	t.Run("test is valid date - ok - four-digit year", func(t *testing.T) {
+		ok, err := IsValidDate("Thu, 01 Jan 1970 00:00:00 GMT")
+		assert.True(t, ok)
+		assert.Nil(t, err)
+	})
+	t.Run("test is valid date - fail - invalid", func(t *testing.T) {
+		ok, err := IsValidDate("1970-01-01")
+		assert.False(t, ok)
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+}
+
+func TestParseDate(t *testing.T) {
+	t.Run("test parse date - ok", func(t *testing.T) {
+		d, err := ParseDate("Tue, 10 Jun 2003 04:00:00 GMT")
+		assert.Nil(t, err)
+		assert.True(t, time.Date(2003, time.June, 10, 4, 0, 0, 0, time.UTC).Equal(d))
+	})
+	t.Run("test parse date - ok - numeric time zone", func(t *testing.T) {
+		d, err := ParseDate("Tue, 10 Jun 2003 06:00:00 +0200")
+		assert.Nil(t, err)
+		assert.True(t, time.Date(2003, time.June, 10, 4, 0, 0, 0, time.UTC).Equal(d))
+	})
+	t.Run("test parse date - fail", func(t *testing.T) {
+		d, err := ParseDate("10 June 2003")
+		assert.True(t, d.IsZero())
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+}
+
+func TestFormatDate(t *testing.T) {
+	t.Run("test format date", func(t *testing.T) {
+		loc := time.FixedZone("EDT", -4*60*60)
+		s := FormatDate(time.Date(2003, time.June, 10, 0, 0, 0, 0, loc))
+		assert.Equal(t, "Tue, 10 Jun 2003 04:00:00 GMT", s)
+		ok, err := IsValidDate(s)
+		assert.True(t, ok)
+		assert.Nil(t, err)
+	})
+}
+
+func TestTranslateDate(t *testing.T) {
+	tests := []struct {
+		lang, s, want string
+	}{
+		{"fr", "ven., 01 mars 2024 11:30:00 GMT", "Fri, 01 Mar 2024 11:30:00 GMT"},
+		{"fr-FR", "Mar, 05 Mar 2024 11:30:00 GMT", "Tue, 05 Mar 2024 11:30:00 GMT"},
+		{"fr", "dimanche, 17 août 2003 09:00:00 GMT", "Sun, 17 Aug 2003 09:00:00 GMT"},
+		{"de", "Mo, 03 Juni 2024 08:00:00 GMT", "Mon, 03 Jun 2024 08:00:00 GMT"},
+		{"es", "mié, 01 ene 2025 00:00:00 GMT", "Wed, 01 Jan 2025 00:00:00 GMT"},
+		{"it", "ven, 01 mar 2024 11:30:00 GMT", "ven, 01 mar 2024 11:30:00 GMT"},
+	}
+	for _, tt := range tests {
+		t.Run("test translate date - "+tt.lang, func(t *testing.T) {
+			assert.Equal(t, tt.want, translateDate(tt.s, tt.lang))
+		})
+	}
+}
+
+func TestNormalizeMIME(t *testing.T) {
+	t.Run("test normalize mime - bare", func(t *testing.T) {
+		mediatype, params, err := normalizeMIME("audio/mpeg")
+		assert.Equal(t, "audio/mpeg", mediatype)
+		assert.Empty(t, params)
+		assert.Nil(t, err)
+	})
+	t.Run("test normalize mime - parameterized", func(t *testing.T) {
+		mediatype, params, err := normalizeMIME("Audio/MP4; codecs=mp4a.40.2")
+		assert.Equal(t, "audio/mp4", mediatype)
+		assert.Equal(t, map[string]string{"codecs": "mp4a.40.2"}, params)
+		assert.Nil(t, err)
+	})
+	t.Run("test normalize mime - fail", func(t *testing.T) {
+		for _, s := range []string{"audio", "/mpeg", "audio/mpeg; codecs"} {
+			_, _, err := normalizeMIME(s)
+			assert.ErrorIs(t, err, ErrInvalidValue, s)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Validation options for the rss package.
+package rss
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A ValidateOption configures how an RSS document is validated by
+// ValidateWith.
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	allowEnclosureOnlyItems bool
+	lenientBooleans         bool
+	httpsOnly               bool
+	strictRating            bool
+	lenientDates            bool
+	requireLanguage         bool
+	strictCloud             bool
+	dateLocale              string
+}
+
+// Treats a valid <enclosure> as the content of an <item>, so that an <item>
+// with neither <title> nor <description> (e.g. a podcast episode) is valid.
+func WithAllowEnclosureOnlyItems() ValidateOption {
+	return func(o *validateOptions) { o.allowEnclosureOnlyItems = true }
+}
+
+// Accepts the common variants of boolean values (e.g. "yes", "No", "1", "TRUE")
+// in 'isPermaLink' of <guid> and <itunes:explicit>, which otherwise must be
+// exactly "true" or "false" (or, for <itunes:explicit>, "yes", "no", or
+// "clean").
+func WithLenientBooleans() ValidateOption {
+	return func(o *validateOptions) { o.lenientBooleans = true }
+}
+
+// Requires every URL of the RSS document to use HTTPS: the <link>s of
+// <channel>, <image>, <textInput>, and <item>, the <url> of <image>, the 'url'
+// of <enclosure> and <source>, <comments>, and the 'href' of <atom:link>.
+// An http URL is reported as ErrInvalidURI.
+func WithHTTPSOnly() ValidateOption {
+	return func(o *validateOptions) { o.httpsOnly = true }
+}
+
+// Requires a non-empty <rating> to be a PICS label (e.g. (PICS-1.1
+// "http://www.classify.org/safesurf/" l r (SS~~000 1))). A malformed label is
+// reported as ErrInvalidValue. By default, any <rating> is valid.
+func WithStrictRating() ValidateOption {
+	return func(o *validateOptions) { o.strictRating = true }
+}
+
+// Reports an unparsable <pubDate> or <lastBuildDate> as a Warning rather than
+// an error, so that the date is treated as unknown. PubDate.Time returns the
+// zero time for such a date under this option.
+func WithLenientDates() ValidateOption {
+	return func(o *validateOptions) { o.lenientDates = true }
+}
+
+// Accepts a <pubDate> or <lastBuildDate> whose weekday and month are named in
+// the locale 'lang' (e.g. "fr" or "fr-FR"), such as
+// "ven., 01 mars 2024 11:30:00 GMT". The names are translated to English before
+// the date is parsed. PubDate.Time parses such a date under this option.
+//
+// French ("fr"), German ("de"), and Spanish ("es") are supported. Dates in other
+// locales must be in English.
+func WithDateLocale(lang string) ValidateOption {
+	return func(o *validateOptions) { o.dateLocale = lang }
+}
+
+// Requires <channel> to contain a <language>, which must be a language code
+// (ISO 639), optionally followed by a country code (e.g. "en" or "en-us").
+func WithRequireLanguage() ValidateOption {
+	return func(o *validateOptions) { o.requireLanguage = true }
+}
+
+// Requires 'registerProcedure' of <cloud> to be valid for its 'protocol': an
+// XML-RPC method name (e.g. "myCloud.rssPleaseNotify") for "xml-rpc" and an
+// XML name for "soap". It is not checked for "http-post", which ignores it.
+// An invalid procedure is reported as ErrInvalidValue.
+func WithStrictCloud() ValidateOption {
+	return func(o *validateOptions) { o.strictCloud = true }
+}
+
+// Returns whether the RSS document 'r' is valid and a slice containing any
+// errors, as configured by 'opts'.
+//
+// Without options, ValidateWith is equivalent to r.IsValid(). Options either
+// relax a rule of the RSS 2.0 Specification (e.g. WithAllowEnclosureOnlyItems)
+// or add a rule of their own. A relaxed rule may be reported as a Warning,
+// which does not make the document invalid.
+func ValidateWith(r *RSS, opts ...ValidateOption) (bool, []error) {
+	o := validateOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return r.validate(o)
+}
+
+// An optionValidator is an RSSElement whose validation depends on the options
+// of ValidateWith.
+type optionValidator interface {
+	validate(o validateOptions) (bool, []error)
+}
+
+// Returns whether 'r' is valid, as configured by 'o', and a slice containing
+// any errors.
+func isValidWith(r RSSElement, o validateOptions) (bool, []error) {
+	if v, ok := r.(optionValidator); ok {
+		return v.validate(o)
+	}
+	return r.IsValid()
+}
+
+// Returns 'isValid' and 'errs' with an error appended if WithHTTPSOnly is set
+// and 'u' is an http URL. 'msg' describes the element or attribute of 'u'.
+func (o validateOptions) requireHTTPS(isValid bool, errs []error, msg, u string) (bool, []error) {
+	if o.httpsOnly && strings.HasPrefix(strings.ToLower(strings.TrimSpace(u)), "http://") {
+		return false, append(errs, fmt.Errorf("%s: %w: must use HTTPS", msg, ErrInvalidURI))
+	}
+	return isValid, errs
+}
+
+// Returns 'isValid' and 'errs' of a date element, with each error converted to
+// a Warning if WithLenientDates is set.
+func (o validateOptions) lenientDate(isValid bool, errs []error) (bool, []error) {
+	if !o.lenientDates || isValid {
+		return isValid, errs
+	}
+	warnings := make([]error, len(errs))
+	for i, err := range errs {
+		warnings[i] = &Warning{Err: err}
+	}
+	return true, warnings
+}
+
+// Parses the date 's', as configured by 'o'. If 's' is not a valid date and
+// WithDateLocale is set, it is parsed with its weekday and month translated to
+// English.
+func (o validateOptions) parseDate(s string) (time.Time, error) {
+	t, err := ParseDate(s)
+	if err != nil && o.dateLocale != "" {
+		if t, err := ParseDate(translateDate(s, o.dateLocale)); err == nil {
+			return t, nil
+		}
+	}
+	return t, err
+}
+
+// Parses the boolean value 's'.
+//
+// If 'lenient' is false, 's' must be exactly "true" or "false". Otherwise,
+// "true", "yes", and "1" are true and "false", "no", and "0" are false,
+// ignoring case and surrounding whitespace.
+func parseBool(s string, lenient bool) (bool, error) {
+	if lenient {
+		s = strings.ToLower(strings.TrimSpace(s))
+		switch s {
+		case "yes", "1":
+			return true, nil
+		case "no", "0":
+			return false, nil
+		}
+	}
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("%w: must be \"true\" or \"false\"", ErrInvalidValue)
+}
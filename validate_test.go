@@ -0,0 +1,340 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Returns the RSS document with <channel> containing 'channel', which must
+// follow the required sub-elements of <channel>.
+func parseChannel(t *testing.T, channel string) *RSS {
+	t.Helper()
+	data := `<rss version="2.0"><channel><title>Title</title><link>https://example.com</link>` +
+		`<description>Description</description>` + channel + `</channel></rss>`
+	r, err := Parse(strings.NewReader(data))
+	assert.Nil(t, err)
+	return r
+}
+
+func TestValidateWith(t *testing.T) {
+	t.Run("test validate with - no options", func(t *testing.T) {
+		r := parseChannel(t, `<item><title>1</title></item><item></item>`)
+		ret, errs := ValidateWith(r)
+		wantRet, wantErrs := r.IsValid()
+		assert.Equal(t, wantRet, ret)
+		assert.Equal(t, wantErrs, errs)
+	})
+}
+
+func TestWithAllowEnclosureOnlyItems(t *testing.T) {
+	t.Run("test allow enclosure-only items - ok", func(t *testing.T) {
+		r := parseChannel(t, `<item><enclosure url="https://example.com/1.mp3" length="1" type="audio/mpeg"/></item>`)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidElement)
+		ret, errs = ValidateWith(r, WithAllowEnclosureOnlyItems())
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test allow enclosure-only items - fail - invalid enclosure", func(t *testing.T) {
+		r := parseChannel(t, `<item><enclosure url="https://example.com/1.mp3" type="audio/mpeg"/></item>`)
+		ret, errs := ValidateWith(r, WithAllowEnclosureOnlyItems())
+		assert.False(t, ret)
+		assert.Len(t, errs, 2)
+		assert.ErrorContains(t, errs[0], "one of <title> or <description> must be present")
+		assert.ErrorContains(t, errs[1], "Attribute 'length' of <enclosure> is required")
+	})
+	t.Run("test allow enclosure-only items - fail - no enclosure", func(t *testing.T) {
+		r := parseChannel(t, `<item></item>`)
+		ret, errs := ValidateWith(r, WithAllowEnclosureOnlyItems())
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidElement)
+	})
+}
+
+func TestWithLenientBooleans(t *testing.T) {
+	t.Run("test lenient booleans - isPermaLink", func(t *testing.T) {
+		r := parseChannel(t, `<item><title>1</title><guid isPermaLink="yes">https://example.com/1</guid></item>`+
+			`<item><title>2</title><guid isPermaLink="No">2</guid></item>`)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 2)
+		for _, err := range errs {
+			assert.ErrorIs(t, err, ErrInvalidValue)
+		}
+		ret, errs = ValidateWith(r, WithLenientBooleans())
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test lenient booleans - isPermaLink - fail - not a uri", func(t *testing.T) {
+		r := parseChannel(t, `<item><title>1</title><guid isPermaLink="1">1</guid></item>`)
+		ret, errs := ValidateWith(r, WithLenientBooleans())
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidURI)
+	})
+	t.Run("test lenient booleans - itunes:explicit", func(t *testing.T) {
+		r := parseChannel(t, `<itunes:explicit xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">Yes</itunes:explicit>`)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		ret, errs = ValidateWith(r, WithLenientBooleans())
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test lenient booleans - fail - unknown value", func(t *testing.T) {
+		r := parseChannel(t, `<item><title>1</title><guid isPermaLink="maybe">https://example.com/1</guid></item>`)
+		ret, errs := ValidateWith(r, WithLenientBooleans())
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidValue)
+	})
+}
+
+func TestParseBool(t *testing.T) {
+	for _, tc := range []struct {
+		s       string
+		lenient bool
+		want    bool
+		wantErr bool
+	}{
+		{"true", false, true, false},
+		{"false", false, false, false},
+		{"yes", false, false, true},
+		{"True", false, false, true},
+		{"1", false, false, true},
+		{"yes", true, true, false},
+		{" True ", true, true, false},
+		{"1", true, true, false},
+		{"NO", true, false, false},
+		{"0", true, false, false},
+		{"maybe", true, false, true},
+	} {
+		t.Run("test parse bool - "+tc.s, func(t *testing.T) {
+			b, err := parseBool(tc.s, tc.lenient)
+			assert.Equal(t, tc.want, b)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidValue)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestWithHTTPSOnly(t *testing.T) {
+	t.Run("test https only - ok", func(t *testing.T) {
+		r := parseChannel(t, `<item><title>1</title><link>https://example.com/1</link></item>`)
+		ret, errs := ValidateWith(r, WithHTTPSOnly())
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test https only - fail - link", func(t *testing.T) {
+		r := parseChannel(t, `<item><title>1</title><link>http://example.com/1</link></item>`)
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		ret, errs = ValidateWith(r, WithHTTPSOnly())
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidURI)
+		assert.EqualError(t, errs[0], "channel > item[0] (link 'http://example.com/1'): "+
+			"Element <link> value 'http://example.com/1' is invalid: "+
+			"Element must contain a valid URI (RFC3986): must use HTTPS")
+	})
+	t.Run("test https only - fail - all elements", func(t *testing.T) {
+		r := parseChannel(t, `<atom:link xmlns:atom="http://www.w3.org/2005/Atom" href="http://example.com/feed" rel="self"/>`+
+			`<image><url>http://example.com/image.png</url><title>Title</title><link>http://example.com</link></image>`+
+			`<textInput><title>Search</title><description>Search</description><name>q</name><link>http://example.com/search</link></textInput>`+
+			`<item><title>1</title><link>http://example.com/1</link><comments>http://example.com/1#comments</comments>`+
+			`<enclosure url="http://example.com/1.mp3" length="1" type="audio/mpeg"/>`+
+			`<source url="http://example.org/rss">Source</source></item>`)
+		ret, errs := ValidateWith(r, WithHTTPSOnly())
+		assert.False(t, ret)
+		assert.Len(t, errs, 8)
+		for _, err := range errs {
+			assert.ErrorIs(t, err, ErrInvalidURI)
+			assert.ErrorContains(t, err, "must use HTTPS")
+		}
+	})
+}
+
+func TestWithStrictRating(t *testing.T) {
+	for _, s := range []string{
+		`(PICS-1.1 "http://www.classify.org/safesurf/" l r (SS~~000 1))`,
+		`(PICS-1.1 "http://www.rsac.org/ratingsv01.html" labels on "1994.11.05T08:15-0500" r (n 0 s 0 v 0 l 0))`,
+		`(PICS-1.0 "http://www.rsac.org/ratingsv01.html" l gen true for "http://example.com" r (n 0 s 0 v 0 l 0))`,
+	} {
+		t.Run("test strict rating - ok - "+s, func(t *testing.T) {
+			r := parseChannel(t, `<rating>`+s+`</rating>`)
+			ret, errs := ValidateWith(r, WithStrictRating())
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+		})
+	}
+	for _, s := range []string{"PG-13", `(PICS-1.1 l r (SS~~000 1))`, `(PICS-1.1 "http://www.classify.org/safesurf/" l r (SS~~000 1)`} {
+		t.Run("test strict rating - fail - "+s, func(t *testing.T) {
+			r := parseChannel(t, `<rating>`+s+`</rating>`)
+			ret, errs := r.IsValid()
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+			ret, errs = ValidateWith(r, WithStrictRating())
+			assert.False(t, ret)
+			assert.Len(t, errs, 1)
+			assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		})
+	}
+}
+
+func TestWithLenientDates(t *testing.T) {
+	t.Run("test lenient dates - ok - warning", func(t *testing.T) {
+		r := parseChannel(t, `<lastBuildDate>yesterday</lastBuildDate>`+
+			`<item><title>1</title><guid>https://example.com/1</guid><pubDate>not a date</pubDate></item>`)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 2)
+		ret, errs = ValidateWith(r, WithLenientDates())
+		assert.True(t, ret)
+		assert.Len(t, errs, 2)
+		for _, err := range errs {
+			var w *Warning
+			assert.ErrorAs(t, err, &w)
+			assert.ErrorIs(t, err, ErrInvalidDate)
+		}
+		assert.ErrorContains(t, errs[1], "channel > item[0] (guid 'https://example.com/1'): warning: "+
+			"Element <pubDate> value 'not a date' is invalid: Element must contain a valid date (RFC822)")
+	})
+	t.Run("test lenient dates - fail - other errors", func(t *testing.T) {
+		r := parseChannel(t, `<item><pubDate>not a date</pubDate></item>`)
+		ret, errs := ValidateWith(r, WithLenientDates())
+		assert.False(t, ret)
+		assert.Len(t, errs, 2)
+		assert.ErrorIs(t, errs[0], ErrInvalidElement)
+		var w *Warning
+		assert.False(t, errors.As(errs[0], &w))
+		assert.ErrorAs(t, errs[1], &w)
+	})
+	t.Run("test lenient dates - pubDate time", func(t *testing.T) {
+		r := PubDate{CharData: []byte("not a date")}
+		tm, err := r.Time()
+		assert.ErrorIs(t, err, ErrInvalidDate)
+		assert.True(t, tm.IsZero())
+		tm, err = r.Time(WithLenientDates())
+		assert.Nil(t, err)
+		assert.True(t, tm.IsZero())
+		r = PubDate{CharData: []byte("Tue, 03 Jun 2003 09:39:21 GMT")}
+		tm, err = r.Time(WithLenientDates())
+		assert.Nil(t, err)
+		assert.Equal(t, time.Date(2003, 6, 3, 9, 39, 21, 0, time.UTC), tm.UTC())
+	})
+}
+
+func TestWithDateLocale(t *testing.T) {
+	t.Run("test date locale - ok - french", func(t *testing.T) {
+		r := parseChannel(t, `<lastBuildDate>lun., 04 mars 2024 08:00:00 GMT</lastBuildDate>`+
+			`<item><title>1</title><pubDate>ven., 01 mars 2024 11:30:00 GMT</pubDate></item>`)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Len(t, errs, 2)
+		ret, errs = ValidateWith(r, WithDateLocale("fr"))
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test date locale - fail - other locale", func(t *testing.T) {
+		r := parseChannel(t, `<item><title>1</title><pubDate>ven., 01 mars 2024 11:30:00 GMT</pubDate></item>`)
+		ret, errs := ValidateWith(r, WithDateLocale("de"))
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidDate)
+		assert.ErrorContains(t, errs[0], "Element <pubDate> value 'ven., 01 mars 2024 11:30:00 GMT' is invalid")
+	})
+	t.Run("test date locale - pubDate time", func(t *testing.T) {
+		r := PubDate{CharData: []byte("ven., 01 mars 2024 11:30:00 GMT")}
+		_, err := r.Time()
+		assert.ErrorIs(t, err, ErrInvalidDate)
+		tm, err := r.Time(WithDateLocale("fr-FR"))
+		assert.Nil(t, err)
+		assert.Equal(t, time.Date(2024, 3, 1, 11, 30, 0, 0, time.UTC), tm.UTC())
+	})
+}
+
+func TestWithRequireLanguage(t *testing.T) {
+	for _, s := range []string{"en", "en-us", "fr-FR", "haw"} {
+		t.Run("test require language - ok - "+s, func(t *testing.T) {
+			r := parseChannel(t, `<language>`+s+`</language>`)
+			ret, errs := ValidateWith(r, WithRequireLanguage())
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+		})
+	}
+	t.Run("test require language - fail - missing", func(t *testing.T) {
+		r := parseChannel(t, ``)
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		ret, errs = ValidateWith(r, WithRequireLanguage())
+		assert.False(t, ret)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidElement)
+		assert.EqualError(t, errs[0], "Element <channel> is invalid: "+
+			"Element must contain required sub-elements and/or attributes: <language> must be present")
+	})
+	for _, s := range []string{"English", "en_US", "e"} {
+		t.Run("test require language - fail - "+s, func(t *testing.T) {
+			r := parseChannel(t, `<language>`+s+`</language>`)
+			ret, errs := r.IsValid()
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+			ret, errs = ValidateWith(r, WithRequireLanguage())
+			assert.False(t, ret)
+			assert.Len(t, errs, 1)
+			assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		})
+	}
+}
+
+func TestWithStrictCloud(t *testing.T) {
+	cloud := func(procedure, protocol string) string {
+		return `<cloud domain="rpc.example.com" port="80" path="/RPC2" registerProcedure="` + procedure +
+			`" protocol="` + protocol + `"/>`
+	}
+	for _, tc := range [][2]string{
+		{"myCloud.rssPleaseNotify", "xml-rpc"},
+		{"pingNotify", "soap"},
+		{"any value", "http-post"},
+	} {
+		t.Run("test strict cloud - ok - "+tc[1], func(t *testing.T) {
+			r := parseChannel(t, cloud(tc[0], tc[1]))
+			ret, errs := ValidateWith(r, WithStrictCloud())
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+		})
+	}
+	for _, tc := range [][2]string{
+		{"my Cloud.rssPleaseNotify", "xml-rpc"},
+		{"ping notify", "soap"},
+		{"1ping", "soap"},
+	} {
+		t.Run("test strict cloud - fail - "+tc[1]+" - "+tc[0], func(t *testing.T) {
+			r := parseChannel(t, cloud(tc[0], tc[1]))
+			ret, errs := r.IsValid()
+			assert.True(t, ret)
+			assert.Empty(t, errs)
+			ret, errs = ValidateWith(r, WithStrictCloud())
+			assert.False(t, ret)
+			assert.Len(t, errs, 1)
+			assert.ErrorIs(t, errs[0], ErrInvalidValue)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Custom validators for the rss package.
+package rss
+
+import (
+	"reflect"
+	"sync"
+)
+
+// A custom validator returns a slice containing any errors for the element.
+type validatorFunc func(RSSElement) []error
+
+// Custom validators keyed by element local name.
+var validators = struct {
+	sync.RWMutex
+	m map[string][]validatorFunc
+}{m: map[string][]validatorFunc{}}
+
+// Registers a custom validator for elements with the local name 'tag' (e.g.
+// "title").
+//
+// Custom validators are run by Validate after the built-in checks (IsValid)
+// for each matching sub-element, by <channel> and <item> for each of their
+// repeated sub-elements (<item>, <category>, <author>, and <atom:link>), and
+// by <rss> for itself. This allows enforcing additional rules (e.g. requiring
+// HTTPS links) beyond the RSS 2.0 Specification.
+//
+// Extension elements are named by their conventional prefix (e.g.
+// "atom:link" or "dc:date"), so "link" matches <link> but not <atom:link>.
+//
+// Example:
+//
+//	RegisterValidator("title", func(r RSSElement) []error {
+//		if t, ok := r.(*Title); ok && strings.Contains(string(t.CharData), "SPAM") {
+//			return []error{errors.New("Element <title> must not contain SPAM")}
+//		}
+//		return nil
+//	})
+//
+// Elements are always passed as a pointer (e.g. *Title), whether or not they
+// are declared as a pointer on their parent element.
+func RegisterValidator(tag string, fn func(RSSElement) []error) {
+	validators.Lock()
+	defer validators.Unlock()
+	validators.m[tag] = append(validators.m[tag], fn)
+}
+
+// Removes all custom validators.
+func ClearValidators() {
+	validators.Lock()
+	defer validators.Unlock()
+	validators.m = map[string][]validatorFunc{}
+}
+
+// Runs the custom validators registered for 'tag' against 'r'. Returns whether
+// 'r' is valid and a slice containing any errors.
+func runValidators(tag string, r RSSElement) (bool, []error) {
+	validators.RLock()
+	fns := validators.m[tag]
+	validators.RUnlock()
+	isValid, errs := true, []error{}
+	for _, fn := range fns {
+		if e := fn(r); len(e) > 0 {
+			isValid = false
+			errs = append(errs, e...)
+		}
+	}
+	return isValid, errs
+}
+
+// Returns the name of the element or attribute represented by the struct
+// field 'f', prefixed with the conventional prefix of its namespace, if any
+// (e.g. "dc:date"). If the "xml" tag does not specify a name, the field name
+// is returned.
+func qualifiedName(f reflect.StructField) string {
+	space, name := tagName(f)
+	if name == "" {
+		return f.Name
+	}
+	if prefix, ok := namespacePrefixes[space]; ok {
+		return prefix + ":" + name
+	}
+	return name
+}
@@ -0,0 +1,263 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errSpam = errors.New("Element must not contain SPAM")
+
+func TestRegisterValidator(t *testing.T) {
+	defer ClearValidators()
+	RegisterValidator("title", func(r RSSElement) []error {
+		if t, ok := r.(*Title); ok && strings.Contains(string(t.CharData), "SPAM") {
+			msg := fmt.Sprintf("Element <%s> value '%s' is invalid", t.XMLName.Local, t.CharData)
+			return []error{fmt.Errorf("%s: %w", msg, errSpam)}
+		}
+		return nil
+	})
+	t.Run("test custom validator - ok", func(t *testing.T) {
+		r := Item{
+			XMLName: xml.Name{Space: "", Local: "item"},
+			Title: &Title{
+				XMLName:  xml.Name{Space: "", Local: "title"},
+				CharData: []byte("Title"),
+			},
+		}
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+	t.Run("test custom validator - fail", func(t *testing.T) {
+		r := Item{
+			XMLName: xml.Name{Space: "", Local: "item"},
+			Title: &Title{
+				XMLName:  xml.Name{Space: "", Local: "title"},
+				CharData: []byte("Buy SPAM"),
+			},
+		}
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], errSpam)
+		assert.ErrorContains(t, errs[0], "Element <title> value 'Buy SPAM' is invalid")
+	})
+	t.Run("test custom validator - cleared", func(t *testing.T) {
+		ClearValidators()
+		r := Item{
+			XMLName: xml.Name{Space: "", Local: "item"},
+			Title: &Title{
+				XMLName:  xml.Name{Space: "", Local: "title"},
+				CharData: []byte("Buy SPAM"),
+			},
+		}
+		ret, errs := r.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+	})
+}
+
+func TestRegisterValidatorPointer(t *testing.T) {
+	defer ClearValidators()
+	calls := map[string]int{}
+	RegisterValidator("title", func(r RSSElement) []error {
+		if t, ok := r.(*Title); ok && strings.Contains(string(t.CharData), "SPAM") {
+			msg := fmt.Sprintf("Element <%s> value '%s' is invalid", t.XMLName.Local, t.CharData)
+			return []error{fmt.Errorf("%s: %w", msg, errSpam)}
+		}
+		return nil
+	})
+	for _, tag := range []string{"rss", "link", "atom:link"} {
+		tag := tag
+		RegisterValidator(tag, func(r RSSElement) []error {
+			calls[tag]++
+			return nil
+		})
+	}
+	data := `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Buy SPAM</title>` +
+		`<link>https://example.com</link><description>Description</description>` +
+		`<atom:link href="https://example.com/feed" rel="self"/>` +
+		`</channel></rss>`
+	t.Run("test custom validator - channel title", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(data))
+		assert.Nil(t, err)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], errSpam)
+		assert.ErrorContains(t, errs[0], "Element <title> value 'Buy SPAM' is invalid")
+		assert.Equal(t, map[string]int{"rss": 1, "link": 1, "atom:link": 1}, calls)
+	})
+}
+
+func TestRegisterValidatorRepeatedElements(t *testing.T) {
+	defer ClearValidators()
+	errNoGUID := errors.New("Element must contain a <guid>")
+	calls := map[string]int{}
+	RegisterValidator("item", func(r RSSElement) []error {
+		calls["item"]++
+		if item, ok := r.(*Item); ok && item.GUID == nil {
+			return []error{fmt.Errorf("Element <item> is invalid: %w", errNoGUID)}
+		}
+		return nil
+	})
+	RegisterValidator("category", func(r RSSElement) []error {
+		calls["category"]++
+		return nil
+	})
+	RegisterValidator("author", func(r RSSElement) []error {
+		calls["author"]++
+		return nil
+	})
+	data := `<rss version="2.0"><channel><title>Title</title><link>https://example.com</link>` +
+		`<description>Description</description><category>News</category>` +
+		`<item><title>1</title><guid isPermaLink="false">1</guid><category>News</category><author>a@example.com</author></item>` +
+		`<item><title>2</title></item>` +
+		`</channel></rss>`
+	t.Run("test custom validator - repeated elements", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(data))
+		assert.Nil(t, err)
+		ret, errs := r.Channel.IsValid()
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], errNoGUID)
+		assert.ErrorContains(t, errs[0], "channel > item[1]: Element <item> is invalid")
+		assert.Equal(t, map[string]int{"item": 2, "category": 2, "author": 1}, calls)
+	})
+}
+
+func TestRegisterValidatorPermalinkHost(t *testing.T) {
+	defer ClearValidators()
+	errHost := errors.New("Element <guid> must have the same host as <link>")
+	RegisterValidator("item", func(r RSSElement) []error {
+		item, ok := r.(*Item)
+		if !ok || item.GUID == nil || item.Link == nil {
+			return nil
+		}
+		guid, ok := item.Permalink()
+		if !ok || guid != string(item.GUID.CharData) {
+			return nil
+		}
+		g, err := url.Parse(guid)
+		if err != nil {
+			return nil
+		}
+		l, err := url.Parse(string(item.Link.CharData))
+		if err != nil || strings.EqualFold(g.Host, l.Host) {
+			return nil
+		}
+		return []error{fmt.Errorf("Element <guid> value '%s' is invalid: %w", guid, errHost)}
+	})
+	data := `<rss version="2.0"><channel><title>Title</title><link>https://example.com</link>` +
+		`<description>Description</description>` +
+		`<item><title>1</title><link>https://example.com/1</link><guid>https://example.com/1</guid></item>` +
+		`<item><title>2</title><link>https://example.com/2</link><guid>https://mirror.example.org/2</guid></item>` +
+		`</channel></rss>`
+	t.Run("test custom validator - permalink host", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(data))
+		assert.Nil(t, err)
+		ret, errs := r.Channel.IsValid()
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], errHost)
+		assert.ErrorContains(t, errs[0], "channel > item[1] (guid 'https://mirror.example.org/2')")
+	})
+}
+
+func TestRegisterValidatorHTTPSOnly(t *testing.T) {
+	defer ClearValidators()
+	errHTTPS := errors.New("URL must use HTTPS")
+	httpsOnly := func(r RSSElement) []error {
+		var tag, u string
+		switch e := r.(type) {
+		case *Link:
+			tag, u = "link", string(e.CharData)
+		case *AtomLink:
+			if e.Href != nil {
+				tag, u = "atom:link", *e.Href
+			}
+		case *Enclosure:
+			if e.URL != nil {
+				tag, u = "enclosure", *e.URL
+			}
+		case *Image:
+			// <url> of <image> is not an RSSElement, so it is checked by <image>.
+			if e.URL != nil {
+				tag, u = "url", *e.URL
+			}
+		case *Comments:
+			tag, u = "comments", string(e.CharData)
+		}
+		if strings.HasPrefix(u, "http://") {
+			return []error{fmt.Errorf("Element <%s> value '%s' is invalid: %w", tag, u, errHTTPS)}
+		}
+		return nil
+	}
+	RegisterValidator("link", httpsOnly)
+	RegisterValidator("atom:link", httpsOnly)
+	RegisterValidator("enclosure", httpsOnly)
+	RegisterValidator("image", httpsOnly)
+	RegisterValidator("comments", httpsOnly)
+	data := `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel><title>Title</title>` +
+		`<link>http://example.com</link><description>Description</description>` +
+		`<atom:link href="http://example.com/feed" rel="self"/>` +
+		`<image><url>http://example.com/image.png</url><title>Title</title><link>http://example.com</link></image>` +
+		`<item><title>1</title><link>http://example.com/1</link>` +
+		`<enclosure url="http://example.com/1.mp3" length="1" type="audio/mpeg"/>` +
+		`<comments>http://example.com/1#comments</comments></item>` +
+		`<item><title>2</title><link>https://example.com/2</link></item>` +
+		`</channel></rss>`
+	t.Run("test custom validator - https only", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(data))
+		assert.Nil(t, err)
+		ret, errs := r.Channel.IsValid()
+		assert.False(t, ret)
+		assert.Equal(t, 7, len(errs))
+		for _, err := range errs {
+			assert.ErrorIs(t, err, errHTTPS)
+		}
+		// <link> of <image> is validated before <image> itself.
+		assert.ErrorContains(t, errs[1], "Element <link> value 'http://example.com' is invalid")
+		assert.ErrorContains(t, errs[2], "Element <url> value 'http://example.com/image.png' is invalid")
+		assert.ErrorContains(t, errs[6], "Element <comments> value 'http://example.com/1#comments' is invalid")
+	})
+}
+
+func TestRegisterValidatorImage(t *testing.T) {
+	defer ClearValidators()
+	errHTTPS := errors.New("URL must use HTTPS")
+	calls := map[string]int{}
+	RegisterValidator("link", func(r RSSElement) []error {
+		calls["link"]++
+		if l, ok := r.(*Link); ok && strings.HasPrefix(string(l.CharData), "http://") {
+			return []error{fmt.Errorf("Element <link> value '%s' is invalid: %w", l.CharData, errHTTPS)}
+		}
+		return nil
+	})
+	RegisterValidator("width", func(r RSSElement) []error {
+		calls["width"]++
+		return nil
+	})
+	data := `<image><url>https://example.com/image.png</url><title>Title</title>` +
+		`<link>http://example.com</link><width>88</width></image>`
+	t.Run("test custom validator - image", func(t *testing.T) {
+		r := Image{}
+		err := xml.Unmarshal([]byte(data), &r)
+		assert.Nil(t, err)
+		ret, errs := r.IsValid()
+		assert.False(t, ret)
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorIs(t, errs[0], errHTTPS)
+		assert.Equal(t, map[string]int{"link": 1, "width": 1}, calls)
+	})
+}
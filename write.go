@@ -0,0 +1,189 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Writing functions for the rss package.
+package rss
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// A WriteOption configures how an RSS document is written.
+type WriteOption func(*writeOptions)
+
+type writeOptions struct {
+	prefix          string
+	indent          string
+	originalCharset bool
+	buildDate       time.Time
+	atomUpdated     bool
+}
+
+// Begins each line with 'prefix' and indents elements by one or more copies
+// of 'indent' (e.g. "\t") according to their depth.
+//
+// If both 'prefix' and 'indent' are empty, the document is written on a single
+// line. By default, elements are indented by two spaces.
+func WithIndent(prefix, indent string) WriteOption {
+	return func(o *writeOptions) { o.prefix, o.indent = prefix, indent }
+}
+
+// Writes the RSS document in the character set from which it was parsed (see
+// RSS.Charset), with a matching XML declaration, rather than UTF-8. Only
+// ISO-8859-1 and US-ASCII are supported.
+//
+// Characters that are not in the character set are written as character
+// references (e.g. "&#8364;" for "€"). Character references are not
+// interpreted within XML comments.
+func WithOriginalCharset() WriteOption {
+	return func(o *writeOptions) { o.originalCharset = true }
+}
+
+// Sets the <lastBuildDate> of <channel> to 't', formatted as time.RFC1123Z in
+// the time zone of 't' (e.g. "Fri, 01 Mar 2024 12:30:00 +0100"), in the
+// written document (e.g. WithBuildDate(time.Now()) for the time of writing).
+// The RSS document itself is not modified.
+//
+// By default, <lastBuildDate> is written as it is.
+func WithBuildDate(t time.Time) WriteOption {
+	return func(o *writeOptions) { o.buildDate = t }
+}
+
+// Sets the <atom:updated> of each <item> with a <pubDate> to its <pubDate>,
+// formatted as RFC3339, in the written document, for readers that expect
+// Atom dates. The RSS document itself is not modified.
+//
+// Items whose <pubDate> is absent or cannot be parsed are written as they are.
+func WithAtomUpdated() WriteOption {
+	return func(o *writeOptions) { o.atomUpdated = true }
+}
+
+// Writes the RSS document, preceded by the XML declaration, to 'w'. Elements
+// are indented by two spaces, unless overridden with WithIndent.
+//
+// The number of bytes returned is the number of bytes written to 'w',
+// including when an error occurs part way through.
+func (r *RSS) Write(w io.Writer, opts ...WriteOption) (int64, error) {
+	o := writeOptions{indent: "  "}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if (!o.buildDate.IsZero() || o.atomUpdated) && r.Channel != nil {
+		c, channel := *r, *r.Channel
+		if !o.buildDate.IsZero() {
+			channel.LastBuildDate = &LastBuildDate{
+				XMLName:  xml.Name{Space: "", Local: "lastBuildDate"},
+				CharData: []byte(o.buildDate.Format(time.RFC1123Z)),
+			}
+		}
+		if o.atomUpdated {
+			channel.Item = withAtomUpdated(channel.Item)
+		}
+		c.Channel = &channel
+		r = &c
+	}
+	cw := &countingWriter{w: w}
+	if charset := canonicalCharset(r.Charset); o.originalCharset && charset != "" {
+		err := r.writeCharset(cw, charset, o)
+		return cw.n, err
+	}
+	if _, err := io.WriteString(cw, xml.Header); err != nil {
+		return cw.n, err
+	}
+	enc := xml.NewEncoder(cw)
+	enc.Indent(o.prefix, o.indent)
+	if err := enc.Encode(r); err != nil {
+		return cw.n, err
+	}
+	if _, err := io.WriteString(cw, "\n"); err != nil {
+		return cw.n, err
+	}
+	return cw.n, nil
+}
+
+// Returns a copy of 'items' in which each <item> with a valid <pubDate> has an
+// <atom:updated> with the same time (RFC3339).
+func withAtomUpdated(items []*Item) []*Item {
+	updated := make([]*Item, len(items))
+	for i, item := range items {
+		updated[i] = item
+		if item == nil || item.PubDate == nil {
+			continue
+		}
+		t, err := ParseDate(string(item.PubDate.CharData))
+		if err != nil {
+			continue
+		}
+		u := *item
+		u.AtomUpdated = &AtomUpdated{
+			XMLName:  xml.Name{Space: ATOMNAMESPACE, Local: "updated"},
+			CharData: []byte(t.Format(time.RFC3339)),
+		}
+		updated[i] = &u
+	}
+	return updated
+}
+
+// Writes the RSS document encoded as 'charset' (ISO-8859-1 or US-ASCII),
+// preceded by the XML declaration, to 'w'.
+func (r *RSS) writeCharset(w io.Writer, charset string, o writeOptions) error {
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	enc.Indent(o.prefix, o.indent)
+	if err := enc.Encode(r); err != nil {
+		return err
+	}
+	buf.WriteString("\n")
+	if _, err := fmt.Fprintf(w, "<?xml version=\"1.0\" encoding=\"%s\"?>\n", charset); err != nil {
+		return err
+	}
+	_, err := w.Write(encodeCharset(buf.Bytes(), charset))
+	return err
+}
+
+// Writes the RSS document, preceded by the XML declaration, to 'w'. Elements
+// are indented by two spaces.
+//
+// WriteTo implements io.WriterTo. The number of bytes returned is the number
+// of bytes written to 'w', including when an error occurs part way through.
+func (r *RSS) WriteTo(w io.Writer) (int64, error) { return r.Write(w) }
+
+// Returns the canonical form of the RSS document, which is suitable for
+// byte-for-byte comparison of documents.
+//
+// The canonical form is the XML declaration followed by the document on a
+// single line, without XML comments. Empty elements are always written as a
+// start and end tag (e.g. <enclosure ...></enclosure>), regardless of whether
+// they were self-closing (e.g. <enclosure .../>) in the parsed document.
+func (r *RSS) Canonical() ([]byte, error) {
+	c := *r
+	c.XMLComment = ""
+	if r.Channel != nil {
+		channel := *r.Channel
+		channel.XMLComment = ""
+		c.Channel = &channel
+	}
+	var buf bytes.Buffer
+	if _, err := c.Write(&buf, WithIndent("", "")); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// A countingWriter counts the number of bytes written to the underlying
+// io.Writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
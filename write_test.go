@@ -0,0 +1,197 @@
+// Copyright 2022 Nickolas Kraus. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rss
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A limitedWriter writes at most n bytes before returning an error.
+type limitedWriter struct {
+	w io.Writer
+	n int
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > l.n {
+		n, _ := l.w.Write(p[:l.n])
+		l.n = 0
+		return n, errors.New("short write")
+	}
+	l.n -= len(p)
+	return l.w.Write(p)
+}
+
+func TestRSSWriteTo(t *testing.T) {
+	r := RSS{
+		XMLName: xml.Name{Space: "", Local: "rss"},
+		Version: Version("2.0"),
+	}
+	t.Run("test write to", func(t *testing.T) {
+		var buf bytes.Buffer
+		n, err := r.WriteTo(&buf)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(buf.Len()), n)
+		assert.Equal(t, xml.Header+`<rss version="2.0"></rss>`+"\n", buf.String())
+	})
+	t.Run("test write to - fail - short write", func(t *testing.T) {
+		var buf bytes.Buffer
+		n, err := r.WriteTo(&limitedWriter{w: &buf, n: 50})
+		assert.NotNil(t, err)
+		assert.Equal(t, int64(buf.Len()), n)
+		assert.Equal(t, int64(50), n)
+		assert.True(t, strings.HasPrefix(xml.Header+`<rss version="2.0"></rss>`, buf.String()))
+	})
+}
+
+func TestRSSWrite(t *testing.T) {
+	r := RSS{
+		XMLName: xml.Name{Space: "", Local: "rss"},
+		Version: Version("2.0"),
+		Channel: &Channel{
+			XMLName:     xml.Name{Space: "", Local: "channel"},
+			Title:       Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+			Link:        Link{XMLName: xml.Name{Space: "", Local: "link"}, CharData: []byte("https://example.com")},
+			Description: Description{XMLName: xml.Name{Space: "", Local: "description"}, CharData: []byte("Description")},
+		},
+	}
+	t.Run("test write - default", func(t *testing.T) {
+		var a, b bytes.Buffer
+		_, err := r.Write(&a)
+		assert.Nil(t, err)
+		_, err = r.WriteTo(&b)
+		assert.Nil(t, err)
+		assert.Equal(t, b.String(), a.String())
+		assert.Contains(t, a.String(), "\n  <channel>\n    <title>Title</title>\n")
+	})
+	t.Run("test write - tab indent", func(t *testing.T) {
+		var buf bytes.Buffer
+		n, err := r.Write(&buf, WithIndent("", "\t"))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(buf.Len()), n)
+		assert.Equal(t, xml.Header+"<rss version=\"2.0\">\n\t<channel>\n\t\t<title>Title</title>\n"+
+			"\t\t<link>https://example.com</link>\n\t\t<description>Description</description>\n\t</channel>\n</rss>\n", buf.String())
+	})
+	t.Run("test write - compact", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := r.Write(&buf, WithIndent("", ""))
+		assert.Nil(t, err)
+		assert.Equal(t, xml.Header+`<rss version="2.0"><channel><title>Title</title><link>https://example.com</link>`+
+			`<description>Description</description></channel></rss>`+"\n", buf.String())
+	})
+}
+
+func TestRSSCanonical(t *testing.T) {
+	feed := `<rss version="2.0"><!-- provenance --><channel><title>Title</title><link>https://example.com</link>` +
+		`<description>Description</description><item><title>Item</title>%s</item></channel></rss>`
+	t.Run("test canonical - empty elements", func(t *testing.T) {
+		a, err := Parse(strings.NewReader(fmt.Sprintf(feed, `<enclosure url="https://example.com/a.mp3" length="1" type="audio/mpeg"/>`)))
+		assert.Nil(t, err)
+		b, err := Parse(strings.NewReader(fmt.Sprintf(feed, `<enclosure url="https://example.com/a.mp3" length="1" type="audio/mpeg"></enclosure>`)))
+		assert.Nil(t, err)
+		ca, err := a.Canonical()
+		assert.Nil(t, err)
+		cb, err := b.Canonical()
+		assert.Nil(t, err)
+		assert.Equal(t, string(ca), string(cb))
+		assert.Equal(t, xml.Header+`<rss version="2.0"><channel><title>Title</title><link>https://example.com</link>`+
+			`<description>Description</description><item><title>Item</title>`+
+			`<enclosure url="https://example.com/a.mp3" length="1" type="audio/mpeg"></enclosure>`+
+			`</item></channel></rss>`+"\n", string(ca))
+	})
+	t.Run("test canonical - comments", func(t *testing.T) {
+		r, err := Parse(strings.NewReader(fmt.Sprintf(feed, "")), WithComments())
+		assert.Nil(t, err)
+		c, err := r.Canonical()
+		assert.Nil(t, err)
+		assert.NotContains(t, string(c), "provenance")
+		// The document is not modified.
+		assert.Equal(t, " provenance ", r.XMLComment)
+	})
+}
+
+func TestRSSWriteWithBuildDate(t *testing.T) {
+	r := RSS{
+		XMLName: xml.Name{Space: "", Local: "rss"},
+		Version: Version("2.0"),
+		Channel: &Channel{
+			XMLName:       xml.Name{Space: "", Local: "channel"},
+			Title:         Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+			LastBuildDate: &LastBuildDate{XMLName: xml.Name{Space: "", Local: "lastBuildDate"}, CharData: []byte("Mon, 02 Jan 2006 15:04:05 GMT")},
+		},
+	}
+	t.Run("test write - build date", func(t *testing.T) {
+		var buf bytes.Buffer
+		date := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+		_, err := r.Write(&buf, WithIndent("", ""), WithBuildDate(date))
+		assert.Nil(t, err)
+		assert.Contains(t, buf.String(), "<lastBuildDate>Fri, 01 Mar 2024 12:30:00 +0100</lastBuildDate>")
+		// The written <lastBuildDate> is valid.
+		written, err := Parse(bytes.NewReader(buf.Bytes()))
+		assert.Nil(t, err)
+		ok, errs := written.Channel.LastBuildDate.IsValid()
+		assert.True(t, ok)
+		assert.Empty(t, errs)
+		assert.NotContains(t, buf.String(), "2006")
+		// The RSS document itself is not modified.
+		assert.Equal(t, "Mon, 02 Jan 2006 15:04:05 GMT", string(r.Channel.LastBuildDate.CharData))
+	})
+	t.Run("test write - build date - no channel", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := (&RSS{Version: Version("2.0")}).Write(&buf, WithIndent("", ""), WithBuildDate(time.Now()))
+		assert.Nil(t, err)
+		assert.Equal(t, xml.Header+`<rss version="2.0"></rss>`+"\n", buf.String())
+	})
+}
+
+func TestRSSWriteWithAtomUpdated(t *testing.T) {
+	r := RSS{
+		XMLName: xml.Name{Space: "", Local: "rss"},
+		Version: Version("2.0"),
+		Channel: &Channel{
+			XMLName: xml.Name{Space: "", Local: "channel"},
+			Title:   Title{XMLName: xml.Name{Space: "", Local: "title"}, CharData: []byte("Title")},
+			Item: []*Item{
+				{Title: &Title{CharData: []byte("1")}, PubDate: &PubDate{CharData: []byte("Tue, 03 Jun 2003 09:39:21 GMT")}},
+				{Title: &Title{CharData: []byte("2")}, PubDate: &PubDate{CharData: []byte("not a date")}},
+				{Title: &Title{CharData: []byte("3")}},
+			},
+		},
+	}
+	t.Run("test write - atom updated", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := r.Write(&buf, WithAtomUpdated())
+		assert.Nil(t, err)
+		p, err := Parse(&buf)
+		assert.Nil(t, err)
+		assert.Len(t, p.Channel.Item, 3)
+		pubDate, err := ParseDate(string(r.Channel.Item[0].PubDate.CharData))
+		assert.Nil(t, err)
+		assert.Equal(t, pubDate.Format(time.RFC3339), string(p.Channel.Item[0].AtomUpdated.CharData))
+		assert.Equal(t, "2003-06-03T09:39:21Z", string(p.Channel.Item[0].AtomUpdated.CharData))
+		ret, errs := p.Channel.Item[0].AtomUpdated.IsValid()
+		assert.True(t, ret)
+		assert.Empty(t, errs)
+		assert.Nil(t, p.Channel.Item[1].AtomUpdated)
+		assert.Nil(t, p.Channel.Item[2].AtomUpdated)
+		// The RSS document itself is not modified.
+		assert.Nil(t, r.Channel.Item[0].AtomUpdated)
+	})
+	t.Run("test write - no atom updated", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := r.Write(&buf)
+		assert.Nil(t, err)
+		assert.NotContains(t, buf.String(), "updated")
+	})
+}